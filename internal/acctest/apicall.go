@@ -9,7 +9,9 @@ package acctest
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -19,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall/coverage"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 )
 
 // APICallRecorderWrapper returns a [ConfigureWrapper] that attaches rec
@@ -139,3 +143,32 @@ func formatCalls(calls []apicall.Call) string {
 	}
 	return strings.Join(parts, ", ")
 }
+
+// apiCoverageRecorder returns the suite Recorder used for the API operation
+// coverage report, installing it on first use.
+var apiCoverageRecorder = sync.OnceValue(func() *apicall.Recorder {
+	rec := apicall.NewRecorder()
+	apicall.SetSuiteRecorder(rec)
+	return rec
+})
+
+// recordAPICoverage, when TF_ACC_API_COVERAGE_DIR is set, records every AWS API
+// operation made during the test and appends the records to the coverage
+// directory when the test completes.
+// See internal/generate/apicallcoverage for generating the report.
+func recordAPICoverage(t *testing.T) {
+	t.Helper()
+
+	dir := os.Getenv(envvar.AccAPICoverageDir)
+	if dir == "" {
+		return
+	}
+
+	rec := apiCoverageRecorder()
+	t.Cleanup(func() {
+		// Calls made by concurrently running tests are flushed by whichever test completes first.
+		if err := coverage.AppendRecords(dir, rec.Drain()); err != nil {
+			t.Errorf("writing API operation coverage records: %s", err)
+		}
+	})
+}
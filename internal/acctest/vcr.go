@@ -68,6 +68,7 @@ import (
 type providerMetaStore struct {
 	provider *schema.Provider
 	meta     *conns.AWSClient
+	redactor *vcr.Redactor
 }
 
 type metaMap map[string]*providerMetaStore
@@ -174,11 +175,12 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		// Reuse the existing VCR-enabled HTTP client if one was already created
 		// for a prior step, otherwise create a new one with a recorder.
 		var httpClient *http.Client
+		var redactor *vcr.Redactor
 		var err error
 		if ok {
-			httpClient = store.meta.HTTPClient(ctx)
+			httpClient, redactor = store.meta.HTTPClient(ctx), store.redactor
 		} else {
-			httpClient, err = vcrHTTPClient(ctx, testName)
+			httpClient, redactor, err = vcrHTTPClient(ctx, testName)
 			if err != nil {
 				return nil, sdkdiag.AppendFromErr(diags, err)
 			}
@@ -196,6 +198,15 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		meta.SetHTTPClient(ctx, httpClient)
 		provider.SetMeta(meta)
 
+		// In offline replay mode the caller identity is read from the cassette's
+		// identity file rather than from STS.
+		if vcr.IsOffline() {
+			ctx, err = vcrOfflineContext(ctx, testName)
+			if err != nil {
+				return nil, sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
 		if v, ds := configureContextFunc(ctx, d); ds.HasError() {
 			return nil, append(diags, ds...)
 		} else {
@@ -208,8 +219,11 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		}
 		meta.SetRandomnessSource(s.source)
 
+		// The caller's account ID is only known once the provider is configured.
+		redactor.AddAccountID(meta.AccountID(ctx))
+
 		providerMetas.Lock()
-		providerMetas[testName] = &providerMetaStore{provider: provider, meta: meta}
+		providerMetas[testName] = &providerMetaStore{provider: provider, meta: meta, redactor: redactor}
 		providerMetas.Unlock()
 
 		return meta, diags
@@ -224,13 +238,20 @@ func vcrSensitiveHeaderHook(i *cassette.Interaction) error {
 }
 
 // vcrMatcherFunc defines how VCR will match requests to stored interactions.
-func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
+//
+// Both the live request and the stored interaction are passed through the
+// redaction pipeline before comparison, so that live requests carrying real
+// account IDs or secrets match their redacted recordings. Fields which vary
+// between runs, such as idempotency tokens, are ignored per [vcr.IgnoredFields].
+func vcrMatcherFunc(ctx context.Context, redactor *vcr.Redactor) recorder.MatcherFunc {
 	return func(r *http.Request, i cassette.Request) bool {
 		if r.Method != i.Method {
 			return false
 		}
 
-		if r.URL.String() != i.URL {
+		ignore := vcr.IgnoredFields(vcr.ServiceFromRequest(r))
+
+		if !vcr.EqualURLs(redactor.Redact(r.URL.String()), redactor.Redact(i.URL), ignore) {
 			return false
 		}
 
@@ -247,19 +268,25 @@ func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
 		}
 
 		r.Body = io.NopCloser(&b)
-		body := b.String()
+		protocol := vcr.ProtocolFromContentType(r.Header.Get("Content-Type"))
+		body := redactor.RedactBody(b.String(), protocol)
+		cassetteBody := redactor.RedactBody(i.Body, protocol)
 		// If body matches identically, we are done.
-		if body == i.Body {
+		if body == cassetteBody {
 			return true
 		}
 
 		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-		switch contentType := r.Header.Get("Content-Type"); contentType {
-		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		switch protocol {
+		case vcr.ProtocolJSON:
 			// JSON might be the same, but reordered. Try parsing and comparing.
-			return tfjson.EqualStrings(body, i.Body)
+			return tfjson.EqualStrings(vcr.StripJSONFields(body, ignore), vcr.StripJSONFields(cassetteBody, ignore))
+
+		case vcr.ProtocolQuery:
+			// Query parameters might be the same, but reordered. Try parsing and comparing.
+			return vcr.EqualQueryBodies(body, cassetteBody, ignore)
 
-		case "application/xml":
+		case vcr.ProtocolXML:
 			// XML might be the same, but reordered. Try parsing and comparing.
 			var requestXML, cassetteXML any
 
@@ -270,7 +297,7 @@ func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
 				return false
 			}
 
-			if err := xml.Unmarshal([]byte(i.Body), &cassetteXML); err != nil {
+			if err := xml.Unmarshal([]byte(cassetteBody), &cassetteXML); err != nil {
 				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
 					"error": err,
 				})
@@ -284,10 +311,12 @@ func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
 	}
 }
 
-func vcrHTTPClient(ctx context.Context, testName string) (*http.Client, error) {
+// vcrHTTPClient returns an HTTP client recording or replaying interactions for
+// the named test, along with the redaction pipeline applied to its cassette.
+func vcrHTTPClient(ctx context.Context, testName string) (*http.Client, *vcr.Redactor, error) {
 	vcrMode, err := vcr.Mode()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Real transport config, cribbed from aws-sdk-go-base.
@@ -302,20 +331,62 @@ func vcrHTTPClient(ctx context.Context, testName string) (*http.Client, error) {
 
 	cassetteName := filepath.Join(vcr.Path(), vcrFileName(testName))
 
-	// Create a VCR recorder around a default HTTP client.
-	r, err := recorder.New(cassetteName,
+	// In offline replay mode nothing may reach the network.
+	realTransport := httpClient.Transport
+	if vcr.IsOffline() {
+		realTransport = vcr.NetworkDisabledTransport
+	}
+
+	// Account IDs and secrets are redacted when the cassette is saved, by which
+	// time the caller's account ID has been added to the pipeline.
+	redactor := vcr.NewRedactor()
+
+	matcher := vcrMatcherFunc(ctx, redactor)
+
+	opts := []recorder.Option{
 		recorder.WithHook(vcrSensitiveHeaderHook, recorder.AfterCaptureHook),
-		recorder.WithMatcher(vcrMatcherFunc(ctx)),
+		recorder.WithHook(redactor.RedactInteraction, recorder.BeforeSaveHook),
+		recorder.WithMatcher(matcher),
 		recorder.WithMode(vcrMode),
-		recorder.WithRealTransport(httpClient.Transport),
+		recorder.WithRealTransport(realTransport),
 		recorder.WithSkipRequestLatency(true),
-	)
+	}
+
+	// Replayed responses carry the configured account IDs and the secrets sent
+	// in matched requests rather than their placeholders.
+	if vcrMode == recorder.ModeReplayOnly && !vcr.ReplayRedacted() {
+		opts = append(opts, recorder.WithHook(redactor.RestoreInteraction, recorder.BeforeResponseReplayHook))
+	}
+
+	// Create a VCR recorder around a default HTTP client.
+	r, err := recorder.New(cassetteName, opts...)
 	if err != nil {
-		return httpClient, err
+		return httpClient, redactor, err
 	}
 
 	httpClient.Transport = r
-	return httpClient, nil
+
+	// Repeated identical requests, such as those made by polling loops, replay
+	// in recorded order.
+	if vcrMode == recorder.ModeReplayOnly {
+		httpClient.Transport, err = vcr.NewReplayTransport(r, cassetteName, matcher)
+		if err != nil {
+			return httpClient, redactor, err
+		}
+	}
+
+	return httpClient, redactor, nil
+}
+
+// vcrOfflineContext returns a context carrying the caller identity recorded
+// for the named test, for use in REPLAY_OFFLINE mode.
+func vcrOfflineContext(ctx context.Context, testName string) (context.Context, error) {
+	identity, err := vcr.ReadIdentityFromFile(vcrIdentityFile(vcr.Path(), testName))
+	if err != nil {
+		return ctx, fmt.Errorf("no caller identity found on disk for %s, please replay this testcase in RECORD_ONLY mode - %w", testName, err)
+	}
+
+	return vcr.NewIdentityContext(ctx, identity), nil
 }
 
 // vcrRandomnessSource returns a rand.Source for VCR testing
@@ -390,6 +461,18 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 				if err := v.Stop(); err != nil {
 					t.Error(err)
 				}
+
+				// Save the caller identity for offline replay.
+				if v.IsRecording() {
+					identity := &vcr.Identity{
+						AccountID: store.redactor.AccountID(store.meta.AccountID(ctx)),
+						Partition: store.meta.Partition(ctx),
+						Region:    store.meta.Region(ctx),
+					}
+					if err := vcr.WriteIdentityToFile(identity, vcrIdentityFile(vcr.Path(), testName)); err != nil {
+						t.Error(err)
+					}
+				}
 			}
 		}
 
@@ -519,6 +602,8 @@ func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 		defer closeVCRRecorder(ctx, t)
 	}
 
+	recordAPICoverage(t)
+
 	resource.ParallelTest(t, c)
 }
 
@@ -533,6 +618,8 @@ func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 		defer closeVCRRecorder(ctx, t)
 	}
 
+	recordAPICoverage(t)
+
 	resource.Test(t, c)
 }
 
@@ -611,6 +698,10 @@ func vcrSeedFile(path, name string) string {
 	return filepath.Join(path, fmt.Sprintf("%s.seed", vcrFileName(name)))
 }
 
+func vcrIdentityFile(path, name string) string {
+	return filepath.Join(path, fmt.Sprintf("%s.identity.json", vcrFileName(name)))
+}
+
 func readSeedFromFile(fileName string) (int64, error) {
 	// Max number of digits for int64 is 19.
 	data := make([]byte, 19)
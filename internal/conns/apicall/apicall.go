@@ -5,24 +5,29 @@
 // through the provider's service clients, for use in tests.
 //
 // The Smithy middleware is opt-in per request: when no Recorder is attached
-// to the operation context (see NewContext), it is a no-op.
+// to the operation context (see NewContext) and no suite Recorder is set, it
+// is a no-op.
 //
 // The middleware runs at the end of Initialize, after RegisterServiceMetadata
 // populates ServiceID and OperationName, and captures the final post-retry
 // error. Each logical SDK operation is recorded once regardless of retries.
 //
-// For richer observability — coverage tooling across the suite, latency
-// histograms, OTEL export — prefer the smithy-go observability surface
-// (TracerProvider / MeterProvider) wired via aws.Config.ServiceOptions.
-// smithyoteltracing.Adapt and smithyotelmetrics.Adapt bridge those to a
-// real OTEL SDK. This package's recorder is intentionally limited to the
-// "did this operation happen" assertion use case.
+// A process-wide suite Recorder (see SetSuiteRecorder) additionally records
+// every operation regardless of context. The acceptance test harness uses it
+// to feed the operation coverage report in the coverage subpackage.
+//
+// For richer observability — latency histograms, OTEL export — prefer the
+// smithy-go observability surface (TracerProvider / MeterProvider) wired via
+// aws.Config.ServiceOptions. internal/tracing does this for traces when
+// enabled. This package's recorder is intentionally limited to "which
+// operations happened".
 package apicall
 
 import (
 	"context"
 	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
@@ -85,6 +90,15 @@ func (r *Recorder) Calls() []Call {
 	return slices.Clone(r.calls)
 }
 
+// Drain returns all recorded calls and clears the call log.
+func (r *Recorder) Drain() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	calls := slices.Clone(r.calls)
+	r.calls = r.calls[:0]
+	return calls
+}
+
 // Mark returns a cursor at the current end of the log.
 //
 // A cursor obtained before Reset points past the end of the post-Reset log;
@@ -165,12 +179,28 @@ func FromContext(ctx context.Context) (*Recorder, bool) {
 	return r, r != nil
 }
 
+// suiteRecorder, when set, records every operation made by the process in
+// addition to any Recorder attached to the operation context.
+var suiteRecorder atomic.Pointer[Recorder]
+
+// SetSuiteRecorder sets the process-wide suite Recorder. A nil r disables
+// suite recording. Acceptance test setup only.
+func SetSuiteRecorder(r *Recorder) {
+	suiteRecorder.Store(r)
+}
+
+// SuiteRecorder returns the process-wide suite Recorder, or nil.
+func SuiteRecorder() *Recorder {
+	return suiteRecorder.Load()
+}
+
 // MiddlewareID is the Smithy stack identifier of the recording middleware.
 const MiddlewareID = "TerraformProviderAWSCallRecorder"
 
 // recorderMiddleware records each operation against the Recorder attached
-// to its context. Runs at Initialize.After: after RegisterServiceMetadata
-// populates ctx, and after the rest of the stack returns the final error.
+// to its context and the suite Recorder, if any. Runs at Initialize.After:
+// after RegisterServiceMetadata populates ctx, and after the rest of the
+// stack returns the final error.
 type recorderMiddleware struct{}
 
 func (recorderMiddleware) ID() string { return MiddlewareID }
@@ -183,17 +213,25 @@ func (recorderMiddleware) HandleInitialize(
 	start := time.Now()
 	out, metadata, err := next.HandleInitialize(ctx, in)
 
-	if rec, ok := FromContext(ctx); ok {
+	rec, ok := FromContext(ctx)
+	suite := SuiteRecorder()
+	if ok || suite != nil {
 		end := time.Now()
 		reqID, _ := awsmiddleware.GetRequestIDMetadata(metadata)
-		rec.RecordCall(Call{
+		c := Call{
 			Service:   awsmiddleware.GetServiceID(ctx),
 			Operation: awsmiddleware.GetOperationName(ctx),
 			Err:       err,
 			At:        end,
 			Duration:  end.Sub(start),
 			RequestID: reqID,
-		})
+		}
+		if ok {
+			rec.RecordCall(c)
+		}
+		if suite != nil && suite != rec {
+			suite.RecordCall(c)
+		}
 	}
 
 	return out, metadata, err
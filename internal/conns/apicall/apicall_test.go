@@ -413,3 +413,40 @@ func TestRecorder_ConcurrentReaderWriter(t *testing.T) {
 		t.Errorf("len(Calls()) = %d, want %d", got, writes)
 	}
 }
+
+func TestMiddleware_RecordsToSuiteRecorder(t *testing.T) { //nolint:paralleltest // Sets the process-wide suite recorder.
+	suite := NewRecorder()
+	SetSuiteRecorder(suite)
+	t.Cleanup(func() { SetSuiteRecorder(nil) })
+
+	r := NewRecorder()
+
+	stack := middleware.NewStack("test", smithyRequestBuilder)
+	if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+		ServiceID:     "S3",
+		OperationName: "GetObject",
+	}, middleware.Before); err != nil {
+		t.Fatalf("adding RegisterServiceMetadata: %v", err)
+	}
+	if err := Middleware()(stack); err != nil {
+		t.Fatalf("adding recorder middleware: %v", err)
+	}
+
+	handler := middleware.DecorateHandler(noopHandler{}, stack)
+	if _, _, err := handler.Handle(context.Background(), nil); err != nil {
+		t.Fatalf("stack.Handle: %v", err)
+	}
+	if _, _, err := handler.Handle(NewContext(context.Background(), r), nil); err != nil {
+		t.Fatalf("stack.Handle: %v", err)
+	}
+
+	if got, want := len(r.Calls()), 1; got != want {
+		t.Errorf("len(Calls()) = %d, want %d", got, want)
+	}
+	if got, want := len(suite.Drain()), 2; got != want {
+		t.Errorf("len(suite.Drain()) = %d, want %d", got, want)
+	}
+	if got, want := len(suite.Calls()), 0; got != want {
+		t.Errorf("len(suite.Calls()) after Drain = %d, want %d", got, want)
+	}
+}
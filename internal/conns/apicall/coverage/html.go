@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package coverage
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"io"
+	"maps"
+	"slices"
+)
+
+//go:embed report.html.gtpl
+var htmlTemplateBody string
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"categories": func() []Category {
+		return []Category{CategoryCreate, CategoryRead, CategoryUpdate, CategoryDelete, CategoryList}
+	},
+	"percent": func(v float64) string {
+		return fmt.Sprintf("%.1f%%", v*100) //nolint:mnd
+	},
+	"sortedKeys": func(m map[string]int) []string {
+		return slices.Sorted(maps.Keys(m))
+	},
+}).Parse(htmlTemplateBody))
+
+// WriteHTML writes a human-readable HTML summary of the report to w.
+func (r *Report) WriteHTML(w io.Writer) error {
+	if err := htmlTemplate.Execute(w, r); err != nil {
+		return fmt.Errorf("writing HTML coverage report: %w", err)
+	}
+
+	return nil
+}
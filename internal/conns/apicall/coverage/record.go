@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package coverage
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
+)
+
+const (
+	recordFilePattern = "apicall-*.jsonl"
+)
+
+// Record is the serialized form of an [apicall.Call].
+type Record struct {
+	Service   string        `json:"service"`
+	Operation string        `json:"operation"`
+	ErrorCode string        `json:"error_code,omitempty"`
+	Duration  time.Duration `json:"duration_ns"`
+}
+
+// NewRecord returns the serialized form of c.
+//
+// Errors are recorded by their AWS API error code when available, and by a
+// generic code otherwise, so that records can be aggregated across runs.
+func NewRecord(c apicall.Call) Record {
+	r := Record{
+		Service:   c.Service,
+		Operation: c.Operation,
+		Duration:  c.Duration,
+	}
+
+	if c.Err != nil {
+		if apiErr, ok := errors.AsType[smithy.APIError](c.Err); ok {
+			r.ErrorCode = apiErr.ErrorCode()
+		} else {
+			r.ErrorCode = "ClientError"
+		}
+	}
+
+	return r
+}
+
+var appendLock sync.Mutex
+
+// AppendRecords appends calls as JSON Lines to this process's record file in dir.
+//
+// Each test binary writes to its own file, so records from a whole acceptance
+// test run can be collected with [ReadRecords] once every package has finished.
+func AppendRecords(dir string, calls []apicall.Call) error {
+	if len(calls) == 0 {
+		return nil
+	}
+
+	appendLock.Lock()
+	defer appendLock.Unlock()
+
+	fileName := filepath.Join(dir, fmt.Sprintf("apicall-%d.jsonl", os.Getpid()))
+	f, err := os.OpenFile(fileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:mnd
+	if err != nil {
+		return fmt.Errorf("opening API call record file (%s): %w", fileName, err)
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, c := range calls {
+		if err := enc.Encode(NewRecord(c)); err != nil {
+			return fmt.Errorf("writing API call record file (%s): %w", fileName, err)
+		}
+	}
+
+	return w.Flush()
+}
+
+// ReadRecords reads all API call records written to dir by [AppendRecords].
+func ReadRecords(dir string) ([]Record, error) {
+	fileNames, err := filepath.Glob(filepath.Join(dir, recordFilePattern))
+	if err != nil {
+		return nil, err
+	}
+
+	var records []Record
+	for _, fileName := range fileNames {
+		v, err := readRecordFile(fileName)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, v...)
+	}
+
+	return records, nil
+}
+
+func readRecordFile(fileName string) ([]Record, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("opening API call record file (%s): %w", fileName, err)
+	}
+	defer f.Close()
+
+	var records []Record
+	dec := json.NewDecoder(f)
+	for dec.More() {
+		var r Record
+		if err := dec.Decode(&r); err != nil {
+			return nil, fmt.Errorf("reading API call record file (%s): %w", fileName, err)
+		}
+		records = append(records, r)
+	}
+
+	return records, nil
+}
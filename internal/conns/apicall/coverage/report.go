@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package coverage aggregates API calls recorded by the apicall suite Recorder
+// across an acceptance test run and compares them to each AWS SDK for Go v2
+// client's full operation list.
+package coverage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Category classifies an operation by the resource lifecycle stage it serves.
+type Category string
+
+const (
+	CategoryCreate Category = "Create"
+	CategoryRead   Category = "Read"
+	CategoryUpdate Category = "Update"
+	CategoryDelete Category = "Delete"
+	CategoryList   Category = "List"
+	CategoryOther  Category = "Other"
+)
+
+var categoryPrefixes = []struct {
+	category Category
+	prefixes []string
+}{
+	{CategoryCreate, []string{"Create", "Allocate", "Associate", "Attach", "Import", "Register", "Run"}},
+	{CategoryRead, []string{"BatchGet", "Describe", "Get"}},
+	{CategoryUpdate, []string{"Change", "Disable", "Enable", "Modify", "Put", "Set", "Update"}},
+	{CategoryDelete, []string{"Delete", "Deregister", "Detach", "Disassociate", "Release", "Remove", "Terminate"}},
+	{CategoryList, []string{"List", "Search"}},
+}
+
+// OperationCategory returns the category of the named operation, based on its verb prefix.
+func OperationCategory(operation string) Category {
+	for _, v := range categoryPrefixes {
+		for _, prefix := range v.prefixes {
+			if rest, ok := strings.CutPrefix(operation, prefix); ok && (rest == "" || isUpper(rest[0])) {
+				return v.category
+			}
+		}
+	}
+
+	return CategoryOther
+}
+
+func isUpper(b byte) bool {
+	return b >= 'A' && b <= 'Z'
+}
+
+var (
+	contextType = reflect.TypeFor[context.Context]()
+	errorType   = reflect.TypeFor[error]()
+)
+
+// ClientOperations returns the names of the API operations exposed by an
+// AWS SDK for Go v2 service client type, e.g. *ec2.Client.
+//
+// Operations are the exported methods with the signature
+// `func(context.Context, *XInput, ...func(*Options)) (*XOutput, error)`.
+func ClientOperations(client reflect.Type) []string {
+	var operations []string
+
+	for method := range client.Methods() {
+		t := method.Type                                          // Includes the receiver.
+		if t.NumIn() != 4 || t.NumOut() != 2 || !t.IsVariadic() { //nolint:mnd // receiver, ctx, input, optFns
+			continue
+		}
+		if t.In(1) != contextType || t.Out(1) != errorType {
+			continue
+		}
+		if in := t.In(2); in.Kind() != reflect.Pointer || in.Elem().Name() != method.Name+"Input" {
+			continue
+		}
+		if out := t.Out(0); out.Kind() != reflect.Pointer || out.Elem().Name() != method.Name+"Output" {
+			continue
+		}
+		operations = append(operations, method.Name)
+	}
+
+	slices.Sort(operations)
+
+	return operations
+}
+
+// Service describes one service client whose coverage is reported.
+type Service struct {
+	ServiceID  string   // Smithy ServiceID, e.g. "EC2". Matches apicall.Call.Service.
+	Package    string   // Provider service package, e.g. "ec2".
+	Operations []string // All operations exposed by the SDK client.
+}
+
+// Report is the operation coverage report for an acceptance test run.
+type Report struct {
+	GeneratedAt time.Time        `json:"generated_at"`
+	TotalCalls  int              `json:"total_calls"`
+	Services    []*ServiceReport `json:"services"`
+}
+
+// ServiceReport is the operation coverage report for a single service package.
+type ServiceReport struct {
+	Package             string                `json:"package"`
+	ServiceID           string                `json:"service_id"`
+	TotalOperations     int                   `json:"total_operations"`
+	ExercisedOperations int                   `json:"exercised_operations"`
+	Coverage            float64               `json:"coverage"`
+	Operations          []*OperationReport    `json:"operations"`
+	Untested            map[Category][]string `json:"untested"`
+	Errors              map[string]int        `json:"errors,omitempty"`
+	Latency             *Latency              `json:"latency,omitempty"`
+	operations          map[string]*OperationReport
+}
+
+// OperationReport is the coverage report for a single exercised operation.
+type OperationReport struct {
+	Name      string         `json:"name"`
+	Category  Category       `json:"category"`
+	Calls     int            `json:"calls"`
+	Errors    map[string]int `json:"errors,omitempty"`
+	Latency   *Latency       `json:"latency,omitempty"`
+	durations []time.Duration
+}
+
+// Latency summarizes the distribution of call durations, including retries.
+type Latency struct {
+	P50 time.Duration `json:"p50_ns"`
+	P90 time.Duration `json:"p90_ns"`
+	P99 time.Duration `json:"p99_ns"`
+	Max time.Duration `json:"max_ns"`
+}
+
+// NewReport aggregates records by service and compares them to the operations
+// of the specified services.
+//
+// Records for services not in services are reported under their ServiceID with
+// no untested operations.
+func NewReport(services []Service, records []Record) *Report {
+	byServiceID := make(map[string]*ServiceReport, len(services))
+	for _, s := range services {
+		byServiceID[s.ServiceID] = &ServiceReport{
+			Package:         s.Package,
+			ServiceID:       s.ServiceID,
+			TotalOperations: len(s.Operations),
+			Untested:        make(map[Category][]string),
+			operations:      make(map[string]*OperationReport, len(s.Operations)),
+		}
+		for _, op := range s.Operations {
+			byServiceID[s.ServiceID].operations[op] = nil
+		}
+	}
+
+	var serviceDurations = make(map[string][]time.Duration)
+	for _, r := range records {
+		s, ok := byServiceID[r.Service]
+		if !ok {
+			s = &ServiceReport{
+				ServiceID:  r.Service,
+				Untested:   make(map[Category][]string),
+				operations: make(map[string]*OperationReport),
+			}
+			byServiceID[r.Service] = s
+		}
+
+		op := s.operations[r.Operation]
+		if op == nil {
+			op = &OperationReport{
+				Name:     r.Operation,
+				Category: OperationCategory(r.Operation),
+			}
+			s.operations[r.Operation] = op
+		}
+
+		op.Calls++
+		op.durations = append(op.durations, r.Duration)
+		serviceDurations[r.Service] = append(serviceDurations[r.Service], r.Duration)
+		if r.ErrorCode != "" {
+			if op.Errors == nil {
+				op.Errors = make(map[string]int)
+			}
+			op.Errors[r.ErrorCode]++
+			if s.Errors == nil {
+				s.Errors = make(map[string]int)
+			}
+			s.Errors[r.ErrorCode]++
+		}
+	}
+
+	report := &Report{
+		GeneratedAt: time.Now().UTC(),
+		TotalCalls:  len(records),
+	}
+
+	for _, serviceID := range slices.Sorted(maps.Keys(byServiceID)) {
+		s := byServiceID[serviceID]
+
+		for _, name := range slices.Sorted(maps.Keys(s.operations)) {
+			op := s.operations[name]
+			if op == nil {
+				if category := OperationCategory(name); category != CategoryOther {
+					s.Untested[category] = append(s.Untested[category], name)
+				}
+				continue
+			}
+
+			op.Latency = newLatency(op.durations)
+			s.Operations = append(s.Operations, op)
+		}
+
+		s.ExercisedOperations = len(s.Operations)
+		if s.TotalOperations < s.ExercisedOperations {
+			s.TotalOperations = s.ExercisedOperations
+		}
+		if s.TotalOperations > 0 {
+			s.Coverage = float64(s.ExercisedOperations) / float64(s.TotalOperations)
+		}
+		s.Latency = newLatency(serviceDurations[serviceID])
+
+		report.Services = append(report.Services, s)
+	}
+
+	slices.SortStableFunc(report.Services, func(a, b *ServiceReport) int {
+		return strings.Compare(a.Package, b.Package)
+	})
+
+	return report
+}
+
+func newLatency(durations []time.Duration) *Latency {
+	if len(durations) == 0 {
+		return nil
+	}
+
+	durations = slices.Clone(durations)
+	slices.Sort(durations)
+
+	return &Latency{
+		P50: percentile(durations, 50), //nolint:mnd
+		P90: percentile(durations, 90), //nolint:mnd
+		P99: percentile(durations, 99), //nolint:mnd
+		Max: durations[len(durations)-1],
+	}
+}
+
+// percentile returns the nearest-rank p-th percentile of sorted.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100 //nolint:mnd // ceil(p/100 * n)
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+// WriteJSON writes the report to w as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(r); err != nil {
+		return fmt.Errorf("writing JSON coverage report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package coverage_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall/coverage"
+)
+
+func TestOperationCategory(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]coverage.Category{
+		"CreateVpc":          coverage.CategoryCreate,
+		"RunInstances":       coverage.CategoryCreate,
+		"DescribeVpcs":       coverage.CategoryRead,
+		"GetBucketPolicy":    coverage.CategoryRead,
+		"ModifyVpcAttribute": coverage.CategoryUpdate,
+		"PutBucketPolicy":    coverage.CategoryUpdate,
+		"DeleteVpc":          coverage.CategoryDelete,
+		"TerminateInstances": coverage.CategoryDelete,
+		"ListBuckets":        coverage.CategoryList,
+		"GetterOfThings":     coverage.CategoryOther,
+		"AcceptVpcPeering":   coverage.CategoryOther,
+	}
+
+	for operation, want := range testCases {
+		t.Run(operation, func(t *testing.T) {
+			t.Parallel()
+
+			if got := coverage.OperationCategory(operation); got != want {
+				t.Errorf("OperationCategory() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+type (
+	exampleOptions    struct{}
+	CreateThingInput  struct{}
+	CreateThingOutput struct{}
+	DeleteThingInput  struct{}
+	DeleteThingOutput struct{}
+	exampleClient     struct{}
+)
+
+func (*exampleClient) CreateThing(context.Context, *CreateThingInput, ...func(*exampleOptions)) (*CreateThingOutput, error) {
+	return nil, nil
+}
+
+func (*exampleClient) DeleteThing(context.Context, *DeleteThingInput, ...func(*exampleOptions)) (*DeleteThingOutput, error) {
+	return nil, nil
+}
+
+func (*exampleClient) Options() exampleOptions {
+	return exampleOptions{}
+}
+
+func TestClientOperations(t *testing.T) {
+	t.Parallel()
+
+	got := coverage.ClientOperations(reflect.TypeFor[*exampleClient]())
+	if diff := cmp.Diff(got, []string{"CreateThing", "DeleteThing"}); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestNewReport(t *testing.T) {
+	t.Parallel()
+
+	services := []coverage.Service{
+		{
+			ServiceID:  "Example",
+			Package:    "example",
+			Operations: []string{"CreateThing", "DeleteThing", "DescribeThing", "ListThings", "TagResource", "UpdateThing"},
+		},
+	}
+	var records []coverage.Record
+	for i := range 10 {
+		records = append(records, coverage.NewRecord(apicall.Call{
+			Service:   "Example",
+			Operation: "DescribeThing",
+			Duration:  time.Duration(i+1) * time.Millisecond,
+		}))
+	}
+	records = append(records,
+		coverage.NewRecord(apicall.Call{Service: "Example", Operation: "CreateThing", Duration: time.Second}),
+		coverage.NewRecord(apicall.Call{Service: "Example", Operation: "DescribeThing", Err: &smithy.GenericAPIError{Code: "ThingNotFound"}}),
+		coverage.NewRecord(apicall.Call{Service: "Other", Operation: "GetWidget", Err: errors.New("dial tcp: timeout")}),
+	)
+
+	report := coverage.NewReport(services, records)
+
+	if got, want := report.TotalCalls, 13; got != want {
+		t.Errorf("TotalCalls = %d, want %d", got, want)
+	}
+	if got, want := len(report.Services), 2; got != want {
+		t.Fatalf("len(Services) = %d, want %d", got, want)
+	}
+
+	example := report.Services[1]
+	if got, want := example.Package, "example"; got != want {
+		t.Errorf("Package = %s, want %s", got, want)
+	}
+	if got, want := example.ExercisedOperations, 2; got != want {
+		t.Errorf("ExercisedOperations = %d, want %d", got, want)
+	}
+	wantUntested := map[coverage.Category][]string{
+		coverage.CategoryDelete: {"DeleteThing"},
+		coverage.CategoryList:   {"ListThings"},
+		coverage.CategoryUpdate: {"UpdateThing"},
+	}
+	if diff := cmp.Diff(example.Untested, wantUntested); diff != "" {
+		t.Errorf("unexpected Untested diff (+want, -got): %s", diff)
+	}
+	if diff := cmp.Diff(example.Errors, map[string]int{"ThingNotFound": 1}); diff != "" {
+		t.Errorf("unexpected Errors diff (+want, -got): %s", diff)
+	}
+
+	describe := example.Operations[1]
+	if got, want := describe.Calls, 11; got != want {
+		t.Errorf("DescribeThing Calls = %d, want %d", got, want)
+	}
+	if got, want := describe.Latency.P50, 5*time.Millisecond; got != want {
+		t.Errorf("DescribeThing P50 = %s, want %s", got, want)
+	}
+	if got, want := describe.Latency.P99, 10*time.Millisecond; got != want {
+		t.Errorf("DescribeThing P99 = %s, want %s", got, want)
+	}
+
+	other := report.Services[0]
+	if diff := cmp.Diff(other.Errors, map[string]int{"ClientError": 1}); diff != "" {
+		t.Errorf("unexpected Errors diff (+want, -got): %s", diff)
+	}
+
+	var html bytes.Buffer
+	if err := report.WriteHTML(&html); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(html.String(), "DeleteThing") {
+		t.Errorf("HTML report does not contain untested operation")
+	}
+}
+
+func TestAppendRecords(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	calls := []apicall.Call{
+		{Service: "Example", Operation: "CreateThing"},
+		{Service: "Example", Operation: "DeleteThing"},
+	}
+
+	if err := coverage.AppendRecords(dir, calls[:1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := coverage.AppendRecords(dir, calls[1:]); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := coverage.ReadRecords(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []coverage.Record{
+		{Service: "Example", Operation: "CreateThing"},
+		{Service: "Example", Operation: "DeleteThing"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
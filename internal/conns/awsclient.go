@@ -11,17 +11,21 @@ import (
 	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used -- Deterministic PRNG required for VCR test reproducibility
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/ratelimit"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -34,9 +38,11 @@ import (
 
 type AWSClient struct {
 	accountID                 string
+	assumeRoleConfigs         map[string]*aws.Config // IAM role ARN -> AWS SDK configuration, for per-resource IAM role overrides.
+	assumeRoleLock            sync.Mutex
 	awsConfig                 *aws.Config
 	callRecorder              *apicall.Recorder         // For acceptance tests asserting which AWS API operations are made.
-	clients                   map[string]map[string]any // Region -> service package name -> API client.
+	clients                   map[string]map[string]any // Region[@IAM role ARN] -> service package name -> API client.
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
@@ -44,7 +50,8 @@ type AWSClient struct {
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
-	randomnessSource          rand.Source // For VCR deterministic randomness.
+	randomnessSource          rand.Source                   // For VCR deterministic randomness.
+	rateLimiters              map[string]*ratelimit.Limiter // Service package name -> client-side rate limiter.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
 	s3OriginalRegion          string // Original region for S3-compatible storage
@@ -76,11 +83,12 @@ func (c *AWSClient) TerraformVersion(_ context.Context) string {
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
-func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider {
-	if c.awsConfig == nil {
+func (c *AWSClient) CredentialsProvider(ctx context.Context) aws.CredentialsProvider {
+	cfg := c.awsConfigForContext(ctx)
+	if cfg == nil {
 		return nil
 	}
-	return c.awsConfig.Credentials
+	return cfg.Credentials
 }
 
 func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
@@ -95,12 +103,19 @@ func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.TagPolicyConfig {
 	return c.tagPolicyConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	return c.awsConfigForContext(ctx).Copy()
 }
 
-// AccountID returns the configured AWS account ID.
-func (c *AWSClient) AccountID(context.Context) string {
+// AccountID returns the configured AWS account ID,
+// or the account ID of any currently in effect per-resource IAM role override.
+func (c *AWSClient) AccountID(ctx context.Context) string {
+	if roleARN := overrideAssumeRoleARN(ctx); roleARN != "" {
+		if v, err := arn.Parse(roleARN); err == nil {
+			return v.AccountID
+		}
+	}
+
 	return c.accountID
 }
 
@@ -264,6 +279,16 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	s3Client := c.S3Client(ctx)
 
+	// The cached S3 Express client uses the provider's credentials.
+	if overrideAssumeRoleARN(ctx) != "" {
+		if s3Client.Options().Region == endpoints.AwsGlobalRegionID {
+			return errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+				"s3_us_east_1_regional_endpoint": "regional",
+			}))
+		}
+		return s3Client
+	}
+
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
@@ -416,6 +441,65 @@ func (c *AWSClient) ValidateInContextRegionInPartition(ctx context.Context) erro
 	return nil
 }
 
+// ValidateInContextAssumeRoleARNInPartition verifies that the value of the top-level `assume_role_arn` attribute is in the configured AWS partition.
+func (c *AWSClient) ValidateInContextAssumeRoleARNInPartition(ctx context.Context) error {
+	if r, p := overrideAssumeRoleARN(ctx), c.Partition(ctx); r != "" && p != "" {
+		v, err := arn.Parse(r)
+		if err != nil {
+			return fmt.Errorf("parsing per-resource IAM role ARN (%s): %w", r, err)
+		}
+		if got, want := v.Partition, p; got != want {
+			return fmt.Errorf("partition (%s) for per-resource IAM role ARN (%s) is not the provider's configured partition (%s)", got, r, want)
+		}
+	}
+
+	return nil
+}
+
+// awsConfigForContext returns the AWS SDK configuration in effect for any per-resource IAM role override.
+// The configuration for each IAM role is built on first use and cached.
+func (c *AWSClient) awsConfigForContext(ctx context.Context) *aws.Config {
+	roleARN := overrideAssumeRoleARN(ctx)
+	if roleARN == "" || c.awsConfig == nil {
+		return c.awsConfig
+	}
+
+	c.assumeRoleLock.Lock()
+	defer c.assumeRoleLock.Unlock()
+
+	if cfg, ok := c.assumeRoleConfigs[roleARN]; ok {
+		return cfg
+	}
+
+	// Credentials for the IAM role are obtained using the provider's credentials.
+	stsClient := sts.NewFromConfig(*c.awsConfig, func(o *sts.Options) {
+		if c.stsRegion != "" {
+			o.Region = c.stsRegion
+		}
+		if v := c.endpoints[names.STS]; v != "" {
+			o.BaseEndpoint = aws.String(v)
+		}
+	})
+	cfg := c.awsConfig.Copy()
+	cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient, roleARN))
+
+	if c.assumeRoleConfigs == nil {
+		c.assumeRoleConfigs = make(map[string]*aws.Config)
+	}
+	c.assumeRoleConfigs[roleARN] = &cfg
+
+	return &cfg
+}
+
+// overrideAssumeRoleARN returns any currently in effect per-resource IAM role override.
+func overrideAssumeRoleARN(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok {
+		return inContext.OverrideAssumeRoleARN()
+	}
+
+	return ""
+}
+
 func convertIPToDashIP(ip string) string {
 	return strings.Replace(ip, ".", "-", -1)
 }
@@ -423,7 +507,7 @@ func convertIPToDashIP(ip string) string {
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": c.awsConfigForContext(ctx),
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
@@ -452,7 +536,11 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
-	region := c.Region(ctx)
+	// Default service clients are cached per Region and per-resource IAM role override.
+	key := c.Region(ctx)
+	if roleARN := overrideAssumeRoleARN(ctx); roleARN != "" {
+		key += "@" + roleARN
+	}
 
 	isDefault := len(extra) == 0
 	// Default service client is cached.
@@ -460,7 +548,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if v, ok := c.clients[region]; ok {
+		if v, ok := c.clients[key]; ok {
 			if raw, ok := v[servicePackageName]; ok {
 				if client, ok := raw.(T); ok {
 					return client, nil
@@ -486,6 +574,12 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 
 	config := c.apiClientConfig(ctx, servicePackageName)
 	maps.Copy(config, extra) // Extras overwrite per-service defaults.
+	if limiter, ok := c.rateLimiters[servicePackageName]; ok {
+		// The limiter is shared by all of the service's API clients, in all Regions.
+		cfg := config["aws_sdkv2_config"].(*aws.Config).Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), limiter.Middleware())
+		config["aws_sdkv2_config"] = &cfg
+	}
 	client, err := v.NewClient(ctx, config)
 	if err != nil {
 		return inttypes.Zero[T](), err
@@ -494,10 +588,10 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		if _, ok := c.clients[region]; !ok {
-			c.clients[region] = make(map[string]any, 0)
+		if _, ok := c.clients[key]; !ok {
+			c.clients[key] = make(map[string]any, 0)
 		}
-		c.clients[region][servicePackageName] = client
+		c.clients[key][servicePackageName] = client
 	}
 
 	return client, nil
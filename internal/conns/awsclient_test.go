@@ -243,7 +243,7 @@ func TestAWSClientValidateInContextRegionInPartition(t *testing.T) { // nosemgre
 		t.Run(testCase.Name, func(t *testing.T) {
 			t.Parallel()
 
-			ctx = NewResourceContext(ctx, "test", "Test", "aws_test_test", testCase.Region)
+			ctx = NewResourceContext(ctx, "test", "Test", "aws_test_test", testCase.Region, "")
 			err := testCase.AWSClient.ValidateInContextRegionInPartition(ctx)
 
 			if got := err == nil; got != testCase.Expected {
@@ -253,6 +253,136 @@ func TestAWSClientValidateInContextRegionInPartition(t *testing.T) { // nosemgre
 	}
 }
 
+func TestAWSClientValidateInContextAssumeRoleARNInPartition(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		AWSClient     *AWSClient
+		AssumeRoleARN string
+		Expected      bool
+	}{
+		{
+			Name: "No override, valid",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+			},
+			Expected: true,
+		},
+		{
+			Name: "AWS Commercial, valid",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+			},
+			AssumeRoleARN: "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+			Expected:      true,
+		},
+		{
+			Name: "AWS Commercial, invalid",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+			},
+			AssumeRoleARN: "arn:aws-cn:iam::123456789012:role/test", //lintignore:AWSAT005
+			Expected:      false,
+		},
+		{
+			Name: "AWS China, valid",
+			AWSClient: &AWSClient{
+				partition: chinaPartition,
+			},
+			AssumeRoleARN: "arn:aws-cn:iam::123456789012:role/test", //lintignore:AWSAT005
+			Expected:      true,
+		},
+		{
+			Name:          "Empty partition, valid",
+			AWSClient:     &AWSClient{},
+			AssumeRoleARN: "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+			Expected:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(t.Context(), "test", "Test", "aws_test_test", "", testCase.AssumeRoleARN)
+			err := testCase.AWSClient.ValidateInContextAssumeRoleARNInPartition(ctx)
+
+			if got := err == nil; got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientAccountID(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		AssumeRoleARN string
+		Expected      string
+	}{
+		{
+			Name:     "No override",
+			Expected: "123456789012",
+		},
+		{
+			Name:          "Override",
+			AssumeRoleARN: "arn:aws:iam::210987654321:role/test", //lintignore:AWSAT005
+			Expected:      "210987654321",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				accountID: "123456789012",
+			}
+			ctx := NewResourceContext(t.Context(), "test", "Test", "aws_test_test", "", testCase.AssumeRoleARN)
+
+			if got, want := client.AccountID(ctx), testCase.Expected; got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientAwsConfigAssumeRoleOverride(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	baseCredentials := aws.AnonymousCredentials{}
+	client := &AWSClient{
+		awsConfig: &aws.Config{
+			Credentials: baseCredentials,
+			Region:      "us-west-2", //lintignore:AWSAT003
+		},
+	}
+
+	ctx := NewResourceContext(t.Context(), "test", "Test", "aws_test_test", "", "")
+	if got, want := client.CredentialsProvider(ctx), aws.CredentialsProvider(baseCredentials); got != want {
+		t.Errorf("no override: got %T, expected %T", got, want)
+	}
+
+	roleARN := "arn:aws:iam::210987654321:role/test" //lintignore:AWSAT005
+	ctx = NewResourceContext(t.Context(), "test", "Test", "aws_test_test", "", roleARN)
+	cfg := client.awsConfigForContext(ctx)
+	if _, ok := cfg.Credentials.(*aws.CredentialsCache); !ok {
+		t.Errorf("override: got %T, expected %T", cfg.Credentials, &aws.CredentialsCache{})
+	}
+	if got, want := cfg.Region, client.awsConfig.Region; got != want {
+		t.Errorf("override: got Region %s, expected %s", got, want)
+	}
+	if got := client.awsConfigForContext(ctx); got != cfg {
+		t.Error("override: AWS configuration not cached")
+	}
+	if got, want := client.awsConfig.Credentials, aws.CredentialsProvider(baseCredentials); got != want {
+		t.Errorf("override: base configuration modified")
+	}
+}
+
 func TestAWSClientGlobalARN(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
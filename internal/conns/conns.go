@@ -55,11 +55,17 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
-	overrideRegion     string // Any currently in effect per-resource Region override.
-	resourceName       string // Friendly resource name, e.g. "Subnet"
-	typeName           string // Resource type name, e.g. "aws_iam_role"
-	servicePackageName string // Canonical name defined as a constant in names package
-	vcrEnabled         bool   // Whether VCR testing is enabled
+	overrideAssumeRoleARN string // Any currently in effect per-resource IAM role override.
+	overrideRegion        string // Any currently in effect per-resource Region override.
+	resourceName          string // Friendly resource name, e.g. "Subnet"
+	typeName              string // Resource type name, e.g. "aws_iam_role"
+	servicePackageName    string // Canonical name defined as a constant in names package
+	vcrEnabled            bool   // Whether VCR testing is enabled
+}
+
+// OverrideAssumeRoleARN returns any currently in effect per-resource IAM role override.
+func (c *InContext) OverrideAssumeRoleARN() string {
+	return c.overrideAssumeRoleARN
 }
 
 // OverrideRegion returns any currently in effect per-resource Region override.
@@ -87,13 +93,14 @@ func (c *InContext) VCREnabled() bool {
 	return c.vcrEnabled
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName, overrideRegion string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName, overrideRegion, overrideAssumeRoleARN string) context.Context {
 	v := InContext{
-		overrideRegion:     overrideRegion,
-		resourceName:       resourceName,
-		typeName:           typeName,
-		servicePackageName: servicePackageName,
-		vcrEnabled:         vcr.IsEnabled(),
+		overrideAssumeRoleARN: overrideAssumeRoleARN,
+		overrideRegion:        overrideRegion,
+		resourceName:          resourceName,
+		typeName:              typeName,
+		servicePackageName:    servicePackageName,
+		vcrEnabled:            vcr.IsEnabled(),
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package ratelimit implements client-side rate limiting and concurrency caps
+// for AWS SDK for Go v2 API clients.
+//
+// A Limiter is shared by every API client for a service package. Its Smithy
+// middleware runs in the Finalize step after the SDK's retry middleware, so
+// each attempt, including retries, is limited. This smooths request bursts
+// before the service throttles them, rather than spending time in the SDK's
+// exponential backoff after it does.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Config is the rate limit configuration for a service.
+// A zero value for either field means no limit.
+type Config struct {
+	RequestsPerSecond float64
+	MaxInFlight       int
+}
+
+// IsZero returns whether the configuration imposes no limits.
+func (c Config) IsZero() bool {
+	return c.RequestsPerSecond <= 0 && c.MaxInFlight <= 0
+}
+
+// Stats are cumulative counters for a Limiter.
+type Stats struct {
+	Requests  int64         // Requests (attempts) made.
+	Delayed   int64         // Requests delayed by the limiter.
+	Waited    time.Duration // Total time requests spent waiting on the limiter.
+	Throttled int64         // Requests the service responded to with a throttling error.
+}
+
+// Limiter limits the rate and concurrency of requests. Construct via New.
+// Safe for concurrent use.
+type Limiter struct {
+	service  string
+	interval time.Duration
+	inFlight chan struct{}
+
+	mu   sync.Mutex
+	next time.Time // Earliest time at which the next request may be sent.
+
+	requests, delayed, waited, throttled atomic.Int64
+}
+
+// New returns a Limiter for the named service with the specified configuration.
+func New(service string, config Config) *Limiter {
+	l := &Limiter{
+		service: service,
+	}
+
+	if v := config.RequestsPerSecond; v > 0 {
+		l.interval = time.Duration(float64(time.Second) / v)
+	}
+	if v := config.MaxInFlight; v > 0 {
+		l.inFlight = make(chan struct{}, v)
+	}
+
+	return l
+}
+
+// Stats returns a snapshot of the Limiter's counters.
+func (l *Limiter) Stats() Stats {
+	return Stats{
+		Requests:  l.requests.Load(),
+		Delayed:   l.delayed.Load(),
+		Waited:    time.Duration(l.waited.Load()),
+		Throttled: l.throttled.Load(),
+	}
+}
+
+// acquire blocks until a request may be sent, returning the time spent waiting.
+// If acquire returns no error, release must be called once the request completes.
+func (l *Limiter) acquire(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return time.Since(start), context.Cause(ctx)
+		}
+	}
+
+	if l.interval > 0 {
+		// Reserve the next send slot. Reservations are spaced by the interval so
+		// that requests are sent at no more than the configured rate, with no bursting.
+		l.mu.Lock()
+		now := time.Now()
+		at := now
+		if l.next.After(now) {
+			at = l.next
+		}
+		l.next = at.Add(l.interval)
+		l.mu.Unlock()
+
+		if d := at.Sub(now); d > 0 {
+			timer := time.NewTimer(d)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				l.release()
+				return time.Since(start), context.Cause(ctx)
+			}
+		}
+	}
+
+	return time.Since(start), nil
+}
+
+func (l *Limiter) release() {
+	if l.inFlight != nil {
+		<-l.inFlight
+	}
+}
+
+// MiddlewareID is the Smithy stack identifier of the rate limiting middleware.
+const MiddlewareID = "TerraformProviderAWSRateLimit"
+
+// Middleware returns a stack mutator that registers the rate limiting middleware
+// on a Smithy stack. Idempotent.
+func (l *Limiter) Middleware() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		if _, ok := stack.Finalize.Get(MiddlewareID); ok {
+			return nil
+		}
+
+		// Run after the retry middleware so that each attempt is limited.
+		if _, ok := stack.Finalize.Get(retryMiddlewareID); ok {
+			return stack.Finalize.Insert(limiterMiddleware{l}, retryMiddlewareID, middleware.After)
+		}
+
+		return stack.Finalize.Add(limiterMiddleware{l}, middleware.Before)
+	}
+}
+
+var (
+	retryMiddlewareID = (&retry.Attempt{}).ID()
+	throttles         = retry.IsErrorThrottles(retry.DefaultThrottles)
+)
+
+type limiterMiddleware struct {
+	limiter *Limiter
+}
+
+func (limiterMiddleware) ID() string { return MiddlewareID }
+
+func (m limiterMiddleware) HandleFinalize(
+	ctx context.Context,
+	in middleware.FinalizeInput,
+	next middleware.FinalizeHandler,
+) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	l := m.limiter
+
+	waited, err := l.acquire(ctx)
+	l.requests.Add(1)
+	if waited >= time.Millisecond {
+		l.delayed.Add(1)
+		l.waited.Add(int64(waited))
+
+		tflog.Debug(ctx, "AWS API request delayed by client-side rate limit", map[string]any{
+			"tf_aws.rate_limit.service":          l.service,
+			"tf_aws.rate_limit.operation":        awsmiddleware.GetOperationName(ctx),
+			"tf_aws.rate_limit.wait":             waited.String(),
+			"tf_aws.rate_limit.delayed_requests": l.delayed.Load(),
+			"tf_aws.rate_limit.total_requests":   l.requests.Load(),
+			"tf_aws.rate_limit.total_wait":       time.Duration(l.waited.Load()).String(),
+		})
+	}
+	if err != nil {
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+	}
+	defer l.release()
+
+	out, metadata, err := next.HandleFinalize(ctx, in)
+
+	if err != nil && throttles.IsErrorThrottle(err) == aws.TrueTernary {
+		l.throttled.Add(1)
+
+		tflog.Debug(ctx, "AWS API request throttled", map[string]any{
+			"tf_aws.rate_limit.service":            l.service,
+			"tf_aws.rate_limit.operation":          awsmiddleware.GetOperationName(ctx),
+			"tf_aws.rate_limit.throttled_requests": l.throttled.Load(),
+			"tf_aws.rate_limit.total_requests":     l.requests.Load(),
+		})
+	}
+
+	return out, metadata, err
+}
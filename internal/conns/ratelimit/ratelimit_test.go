@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestLimiter_RequestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := New("route53", Config{RequestsPerSecond: 20})
+
+	start := time.Now()
+	for range 5 {
+		if _, err := l.acquire(ctx); err != nil {
+			t.Fatal(err)
+		}
+		l.release()
+	}
+
+	// The first request is sent immediately, the remaining 4 are spaced 50ms apart.
+	if got, want := time.Since(start), 200*time.Millisecond; got < want {
+		t.Errorf("elapsed = %s, want >= %s", got, want)
+	}
+}
+
+func TestLimiter_MaxInFlight(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := New("iam", Config{MaxInFlight: 2})
+
+	var (
+		wg                sync.WaitGroup
+		inFlight, maxSeen atomic.Int32
+	)
+	for range 10 {
+		wg.Go(func() {
+			if _, err := l.acquire(ctx); err != nil {
+				t.Error(err)
+				return
+			}
+			defer l.release()
+
+			n := inFlight.Add(1)
+			for {
+				m := maxSeen.Load()
+				if n <= m || maxSeen.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			inFlight.Add(-1)
+		})
+	}
+	wg.Wait()
+
+	if got, want := maxSeen.Load(), int32(2); got > want {
+		t.Errorf("max in flight = %d, want <= %d", got, want)
+	}
+}
+
+func TestLimiter_ContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	l := New("cloudformation", Config{MaxInFlight: 1})
+
+	if _, err := l.acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := l.acquire(ctx); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestMiddleware_CountsThrottles(t *testing.T) {
+	t.Parallel()
+
+	l := New("route53", Config{RequestsPerSecond: 1000, MaxInFlight: 1})
+
+	stack := middleware.NewStack("test", func() any { return struct{}{} })
+	if err := l.Middleware()(stack); err != nil {
+		t.Fatal(err)
+	}
+	// Idempotent.
+	if err := l.Middleware()(stack); err != nil {
+		t.Fatal(err)
+	}
+
+	handler := middleware.DecorateHandler(throttlingHandler{}, stack)
+	for range 3 {
+		if _, _, err := handler.Handle(context.Background(), nil); err == nil {
+			t.Fatal("expected error")
+		}
+	}
+
+	stats := l.Stats()
+	if got, want := stats.Requests, int64(3); got != want {
+		t.Errorf("Requests = %d, want %d", got, want)
+	}
+	if got, want := stats.Throttled, int64(3); got != want {
+		t.Errorf("Throttled = %d, want %d", got, want)
+	}
+}
+
+type throttlingHandler struct{}
+
+func (throttlingHandler) Handle(_ context.Context, _ any) (any, middleware.Metadata, error) {
+	return nil, middleware.Metadata{}, &smithy.GenericAPIError{Code: "Throttling", Message: "Rate exceeded"}
+}
@@ -78,6 +78,10 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For collecting the AWS API operations made by acceptance tests, a directory
+	// to which API call records are written for the operation coverage report
+	AccAPICoverageDir = "TF_ACC_API_COVERAGE_DIR"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -96,6 +100,33 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for controlling resource sweepers
+const (
+	// Set to a truthy value to delete the resources listed by all sweepers in dependency order
+	SweepDependencyOrdered = "TF_AWS_SWEEP_DEPENDENCY_ORDERED"
+
+	// Set to a truthy value to list the resources that sweepers would delete without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated name prefixes. When set, only resources with a matching name (or ID) are swept
+	SweepAllowNamePrefixes = "TF_AWS_SWEEP_ALLOW_NAME_PREFIXES"
+
+	// Comma-separated name prefixes. Resources with a matching name (or ID) are never swept
+	SweepDenyNamePrefixes = "TF_AWS_SWEEP_DENY_NAME_PREFIXES"
+
+	// Comma-separated tag keys or key=value pairs. When set, only resources with a matching tag are swept
+	SweepAllowTags = "TF_AWS_SWEEP_ALLOW_TAGS"
+
+	// Comma-separated tag keys or key=value pairs. Resources with a matching tag are never swept
+	SweepDenyTags = "TF_AWS_SWEEP_DENY_TAGS"
+
+	// A file to which a JSON summary of deleted, skipped and failed resources is written
+	SweepReportFile = "TF_AWS_SWEEP_REPORT_FILE"
+
+	// The number of Regions to sweep concurrently. Defaults to 1
+	SweepRegionParallelism = "TF_AWS_SWEEP_REGION_PARALLELISM"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
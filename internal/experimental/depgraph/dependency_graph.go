@@ -45,12 +45,13 @@ func (g *Graph) AddNode(s string) {
 // RemoveNode removes the specified string from the graph if it is present.
 func (g *Graph) RemoveNode(s string) {
 	if g.HasNode(s) {
-		for n, edges := range g.outgoingEdges {
-			g.outgoingEdges[n] = tfslices.RemoveAll(edges, s)
+		// Only the node's neighbors have edges to or from the node.
+		for _, n := range g.incomingEdges[s] {
+			g.outgoingEdges[n] = tfslices.RemoveAll(g.outgoingEdges[n], s)
 		}
 
-		for n, edges := range g.incomingEdges {
-			g.incomingEdges[n] = tfslices.RemoveAll(edges, s)
+		for _, n := range g.outgoingEdges[s] {
+			g.incomingEdges[n] = tfslices.RemoveAll(g.incomingEdges[n], s)
 		}
 
 		g.nodes = tfslices.RemoveAll(g.nodes, s)
@@ -61,7 +62,9 @@ func (g *Graph) RemoveNode(s string) {
 
 // HasNode returns whether the specified string is in the graph.
 func (g *Graph) HasNode(s string) bool {
-	return slices.Contains(g.nodes, s)
+	// Every node has an entry in the edge maps.
+	_, ok := g.outgoingEdges[s]
+	return ok
 }
 
 // AddDependency adds a dependency between two nodes.
@@ -17,7 +17,8 @@ import (
 )
 
 const (
-	mapBlockKeyFieldName = "MapBlockKey"
+	mapBlockKeyFieldName      = "MapBlockKey"
+	unionMemberValueFieldName = "Value"
 )
 
 // Expand  = TF -->  AWS
@@ -172,6 +173,41 @@ type valueWithElementsAs interface {
 	ElementsAs(context.Context, any, bool) diag.Diagnostics
 }
 
+// Union is implemented by Terraform models of AWS SDK union types, for example Verified Permissions `types.EntityReference`.
+// The model has one field per union member, named after the member, for example `Identifier` for `types.EntityReferenceMemberIdentifier`.
+// Structure members map to nested objects and scalar members to primitive attributes.
+// Exactly one field must be set when expanding. Use an `ExactlyOneOf` validator in the schema so that this is checked during validation.
+type Union interface {
+	// UnionMembers returns a pointer to a zero value of each union member type, for example `&types.EntityReferenceMemberIdentifier{}`.
+	// If the model is used with more than one SDK union type, for example `types.Configuration` and `types.UpdateConfiguration`,
+	// the member types of each union are returned and the expander chooses the member that implements the target union type.
+	UnionMembers() []any
+}
+
+// unionMemberField returns the field of the Terraform union model that corresponds to the specified AWS SDK union member type.
+// SDK union member types are named `<Union>Member<Member>`. Names are compared case-insensitively, for example `OpenIdConnectConfiguration` matches field `OpenIDConnectConfiguration`.
+func unionMemberField(typeModel, typeMember reflect.Type) (reflect.StructField, bool) {
+	if typeMember.Kind() == reflect.Pointer {
+		typeMember = typeMember.Elem()
+	}
+
+	var (
+		result reflect.StructField
+		found  bool
+	)
+	for field := range tfreflect.ExportedStructFields(typeModel) {
+		if !strings.HasSuffix(strings.ToLower(typeMember.Name()), strings.ToLower("Member"+field.Name)) {
+			continue
+		}
+		// The longest match wins, for example `MemberAccounts` over `Accounts`.
+		if !found || len(field.Name) > len(result.Name) {
+			result, found = field, true
+		}
+	}
+
+	return result, found
+}
+
 func diagConvertingTargetIsNil(targetType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
@@ -175,6 +175,12 @@ func expandConvert(ctx context.Context, expander *autoExpander, sourcePath path.
 		return diags
 	}
 
+	if fromUnion, ok := valFrom.Interface().(Union); ok && vTo.Kind() == reflect.Interface {
+		tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.Union")
+		diags.Append(expandUnion(ctx, expander, sourcePath, valFrom, fromUnion, targetPath, vTo)...)
+		return diags
+	}
+
 	vFrom, ok := valFrom.Interface().(attr.Value)
 	if !ok {
 		tflog.SubsystemError(ctx, subsystemName, "Source does not implement attr.Value")
@@ -1392,6 +1398,12 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 		return diags
 	}
 
+	if fromUnion, ok := valFrom.Interface().(Union); ok && valTo.Kind() == reflect.Interface {
+		tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.Union")
+		diags.Append(expandUnion(ctx, expander, sourcePath, valFrom, fromUnion, targetPath, valTo)...)
+		return diags
+	}
+
 	if valTo.Kind() == reflect.Interface {
 		tflog.SubsystemError(ctx, subsystemName, "Expanding to incompatible interface")
 		// TODO: Should continue failing silently for now
@@ -1635,6 +1647,75 @@ func expandTypedExpander(ctx context.Context, fromTypedExpander TypedExpander, t
 	return diags
 }
 
+// expandUnion copies the single set field of a Terraform union model to the corresponding AWS SDK union member.
+func expandUnion(ctx context.Context, expander *autoExpander, sourcePath path.Path, valFrom reflect.Value, fromUnion Union, targetPath path.Path, valTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeFrom := valFrom.Type()
+
+	var fromFields []reflect.StructField
+	for fromField := range expandSourceFields(ctx, typeFrom, expander.Options) {
+		v, ok := valFrom.FieldByIndex(fromField.Index).Interface().(attr.Value)
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		fromFields = append(fromFields, fromField)
+	}
+
+	if n := len(fromFields); n != 1 {
+		tflog.SubsystemError(ctx, subsystemName, "Expanding union", map[string]any{
+			logAttrKeySourceSize: n,
+		})
+		diags.Append(diagExpandingUnionMemberCount(typeFrom, n))
+		return diags
+	}
+
+	fromField := fromFields[0]
+	fromFieldName := fromField.Name
+
+	var typeMember, typeMemberIncompatible reflect.Type
+	for _, member := range fromUnion.UnionMembers() {
+		typ := reflect.TypeOf(member)
+		if toField, ok := unionMemberField(typeFrom, typ); !ok || toField.Name != fromFieldName {
+			continue
+		}
+		if !typ.Implements(valTo.Type()) {
+			typeMemberIncompatible = typ
+			continue
+		}
+		typeMember = typ
+		break
+	}
+
+	if typeMember == nil {
+		if typeMemberIncompatible != nil {
+			diags.Append(diagExpandedTypeDoesNotImplement(typeMemberIncompatible, valTo.Type()))
+			return diags
+		}
+
+		tflog.SubsystemError(ctx, subsystemName, "No union member type for source field", map[string]any{
+			logAttrKeySourceFieldname: fromFieldName,
+		})
+		diags.Append(diagExpandingNoUnionMember(typeFrom, fromFieldName))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: fromFieldName,
+		logAttrKeyTargetType:      fullTypeName(typeMember),
+	})
+
+	to := reflect.New(typeMember.Elem())
+	diags.Append(expandConvert(ctx, expander, sourcePath.AtName(fromFieldName), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(unionMemberValueFieldName), to.Elem().FieldByName(unionMemberValueFieldName), fieldOpts{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	valTo.Set(to)
+
+	return diags
+}
+
 func diagExpandingSourceIsNil(sourceType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
@@ -1695,6 +1776,26 @@ func diagExpandingNoMapBlockKey(sourceType reflect.Type) diag.ErrorDiagnostic {
 	)
 }
 
+func diagExpandingUnionMemberCount(sourceType reflect.Type, n int) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Union",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Exactly one field of union type %q must be set, got %d.", fullTypeName(sourceType), n),
+	)
+}
+
+func diagExpandingNoUnionMember(sourceType reflect.Type, fieldName string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Union type %q has no member type for field %q.", fullTypeName(sourceType), fieldName),
+	)
+}
+
 func diagExpandingIncompatibleTypes(sourceType, targetType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
@@ -210,7 +210,7 @@ func flattenConvert(ctx context.Context, flattener *autoFlattener, sourcePath pa
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattenInterface(ctx, flattener, vFrom, tTo, vTo, fieldOpts)...)
+		diags.Append(flattenInterface(ctx, flattener, sourcePath, vFrom, targetPath, tTo, vTo, fieldOpts)...)
 		return diags
 	}
 
@@ -629,7 +629,7 @@ func flattenPointer(ctx context.Context, flattener *autoFlattener, sourcePath pa
 	return diags
 }
 
-func flattenInterface(ctx context.Context, flattener *autoFlattener, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value, _ fieldOpts) diag.Diagnostics {
+func flattenInterface(ctx context.Context, flattener *autoFlattener, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value, _ fieldOpts) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -670,7 +670,7 @@ func flattenInterface(ctx context.Context, flattener *autoFlattener, vFrom refle
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattenInterfaceToNestedObject(ctx, flattener, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattenInterfaceToNestedObject(ctx, flattener, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1333,6 +1333,7 @@ func flattenStructToNestedObject(ctx context.Context, flattener *autoFlattener,
 		tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.Flattener", map[string]any{
 			logAttrKeyTargetType: fullTypeName(reflect.TypeOf(to)),
 		})
+		recordFlattenRule(ctx, targetPath, fullTypeName(reflect.TypeOf(to))+" implements flex.Flattener")
 		diags.Append(targetFlattener.Flatten(ctx, vFrom.Interface())...)
 		if diags.HasError() {
 			return diags
@@ -1356,7 +1357,7 @@ func flattenStructToNestedObject(ctx context.Context, flattener *autoFlattener,
 }
 
 // flattenInterfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func flattenInterfaceToNestedObject(ctx context.Context, _ *autoFlattener, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func flattenInterfaceToNestedObject(ctx context.Context, flattener *autoFlattener, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1376,6 +1377,25 @@ func flattenInterfaceToNestedObject(ctx context.Context, _ *autoFlattener, vFrom
 		return diags
 	}
 
+	if _, ok := to.(Union); ok {
+		tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.Union")
+		recordFlattenRule(ctx, targetPath, fullTypeName(reflect.TypeOf(to))+" implements flex.Union")
+
+		diags.Append(flattenUnion(ctx, flattener, sourcePath, vFrom.Elem(), targetPath, reflect.ValueOf(to))...)
+		if diags.HasError() {
+			return diags
+		}
+
+		val, d := tTo.ValueFromObjectPtr(ctx, to)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
 	toFlattener, ok := to.(Flattener)
 	if !ok {
 		val, d := tTo.NullValue(ctx)
@@ -1392,6 +1412,7 @@ func flattenInterfaceToNestedObject(ctx context.Context, _ *autoFlattener, vFrom
 	}
 
 	tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.Flattener")
+	recordFlattenRule(ctx, targetPath, fullTypeName(reflect.TypeOf(to))+" implements flex.Flattener")
 
 	// Dereference interface
 	vFrom = vFrom.Elem()
@@ -2140,10 +2161,18 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 
 	if toFlattener, ok := to.(Flattener); ok {
 		tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.Flattener")
+		recordFlattenRule(ctx, targetPath, fullTypeName(reflect.TypeOf(to))+" implements flex.Flattener")
 		diags.Append(flattenFlattener(ctx, valFrom, toFlattener)...)
 		return diags
 	}
 
+	if _, ok := to.(Union); ok {
+		tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.Union")
+		recordFlattenRule(ctx, targetPath, fullTypeName(reflect.TypeOf(to))+" implements flex.Union")
+		diags.Append(flattenUnion(ctx, flattener, sourcePath, valFrom, targetPath, valTo)...)
+		return diags
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
@@ -2210,6 +2239,7 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 			logAttrKeySourceFieldname: fromFieldName,
 			logAttrKeyTargetFieldname: toFieldName,
 		})
+		recordFlattenField(ctx, targetPath, fromField, toField, toFieldOpts)
 
 		// Check if target has wrapper tag and source is an XML wrapper struct
 		if wrapperField := toFieldOpts.XMLWrapperField(); wrapperField != "" {
@@ -2612,6 +2642,51 @@ func flattenFlattener(ctx context.Context, fromVal reflect.Value, toFlattener Fl
 	return diags
 }
 
+// flattenUnion copies an AWS SDK union member to the corresponding field of a Terraform union model.
+// All other fields of the model are set to null.
+func flattenUnion(ctx context.Context, flattener *autoFlattener, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if valFrom.Kind() == reflect.Pointer {
+		valFrom = valFrom.Elem()
+	}
+	if valTo.Kind() == reflect.Pointer {
+		valTo = valTo.Elem()
+	}
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	typeFrom := valFrom.Type()
+	typeTo := valTo.Type()
+
+	toField, ok := unionMemberField(typeTo, typeFrom)
+	if !ok {
+		tflog.SubsystemError(ctx, subsystemName, "No corresponding target field for union member")
+		diags.Append(diagFlatteningNoUnionMemberField(typeFrom, typeTo))
+		return diags
+	}
+	toFieldName := toField.Name
+
+	fromFieldVal := valFrom.FieldByName(unionMemberValueFieldName)
+	if !fromFieldVal.IsValid() {
+		tflog.SubsystemError(ctx, subsystemName, "Union member has no Value field")
+		diags.Append(diagFlatteningNoUnionMemberField(typeFrom, typeTo))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceType:      fullTypeName(typeFrom),
+		logAttrKeyTargetFieldname: toFieldName,
+	})
+
+	diags.Append(flattenConvert(ctx, flattener, sourcePath.AtName(unionMemberValueFieldName), fromFieldVal, targetPath.AtName(toFieldName), valTo.FieldByIndex(toField.Index), fieldOpts{})...)
+
+	return diags
+}
+
 func flattenPrePopulate(ctx context.Context, toVal reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -2706,6 +2781,16 @@ func diagFlatteningMarshalSmithyDocument(sourceType reflect.Type, err error) dia
 	)
 }
 
+func diagFlatteningNoUnionMemberField(sourceType, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while flattening configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Union member type %q has no corresponding field in %q.", fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
+
 func DiagFlatteningIncompatibleTypes(sourceType, targetType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// FlattenTrace records the AutoFlex rule used to flatten each attribute.
+// Rules are keyed by attribute path, e.g. `settings[0].name`.
+type FlattenTrace struct {
+	mu sync.Mutex
+	// attributePaths maps the target model's Go field paths to attribute paths.
+	attributePaths map[string]path.Path
+	rules          map[string]string
+}
+
+type flattenTraceKey struct{}
+
+// NewFlattenTraceContext returns a Context that records the rules used by Flatten.
+func NewFlattenTraceContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, flattenTraceKey{}, &FlattenTrace{
+		attributePaths: make(map[string]path.Path),
+		rules:          make(map[string]string),
+	})
+}
+
+// FlattenTraceFromContext returns the FlattenTrace stored in a Context, if any.
+func FlattenTraceFromContext(ctx context.Context) (*FlattenTrace, bool) {
+	v, ok := ctx.Value(flattenTraceKey{}).(*FlattenTrace)
+	return v, ok
+}
+
+// Rules returns the recorded rules, keyed by attribute path.
+func (t *FlattenTrace) Rules() map[string]string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return maps.Clone(t.rules)
+}
+
+// attributePath converts a target model Go field path to an attribute path.
+// Callers must hold the lock.
+func (t *FlattenTrace) attributePath(targetPath path.Path) path.Path {
+	goPath, attributePath := path.Empty(), path.Empty()
+
+	for _, step := range targetPath.Steps() {
+		switch step := step.(type) {
+		case path.PathStepAttributeName:
+			goPath = goPath.AtName(string(step))
+			if v, ok := t.attributePaths[goPath.String()]; ok {
+				attributePath = v
+			} else {
+				attributePath = attributePath.AtName(string(step))
+			}
+		case path.PathStepElementKeyInt:
+			goPath = goPath.AtListIndex(int(step))
+			attributePath = attributePath.AtListIndex(int(step))
+		case path.PathStepElementKeyString:
+			goPath = goPath.AtMapKey(string(step))
+			attributePath = attributePath.AtMapKey(string(step))
+		}
+	}
+
+	return attributePath
+}
+
+// recordFlattenField records the rule used to flatten a source struct field into a target struct field.
+func recordFlattenField(ctx context.Context, targetPath path.Path, fromField, toField reflect.StructField, toFieldOpts tagOptions) {
+	t, ok := FlattenTraceFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	name := toField.Name
+	if v, _, _ := strings.Cut(toField.Tag.Get("tfsdk"), ","); v != "" && v != "-" {
+		name = v
+	}
+	attributePath := t.attributePath(targetPath).AtName(name)
+	t.attributePaths[targetPath.AtName(toField.Name).String()] = attributePath
+
+	rule := fmt.Sprintf("AWS field %s (%s) flattened to %s by %s", fromField.Name, fromField.Type, toField.Type, fieldMatchRule(fromField.Name, toField.Name))
+	var opts []string
+	if toFieldOpts.Legacy() {
+		opts = append(opts, "legacy")
+	}
+	if toFieldOpts.OmitEmpty() {
+		opts = append(opts, "omitempty")
+	}
+	if v := toFieldOpts.XMLWrapperField(); v != "" {
+		opts = append(opts, "xmlwrapper="+v)
+	}
+	if len(opts) > 0 {
+		rule += fmt.Sprintf(" with options %s", strings.Join(opts, ","))
+	}
+	t.rules[attributePath.String()] = rule
+}
+
+// recordFlattenRule records a rule that applies to a target value and all its attributes.
+func recordFlattenRule(ctx context.Context, targetPath path.Path, rule string) {
+	t, ok := FlattenTraceFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rules[t.attributePath(targetPath).String()] = rule
+}
+
+// fieldMatchRule describes how findField matched a source field name to a target field name.
+func fieldMatchRule(fromFieldName, toFieldName string) string {
+	switch {
+	case fromFieldName == toFieldName:
+		return "exact field name match"
+	case strings.EqualFold(fromFieldName, toFieldName):
+		return "case-insensitive field name match"
+	case toFieldName == plural.Plural(fromFieldName) || toFieldName == plural.Singular(fromFieldName):
+		return "singular/plural field name match"
+	default:
+		return "field name prefix/suffix match"
+	}
+}
@@ -0,0 +1,359 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+// Tests AutoFlex's Expand/Flatten of AWS SDK union types.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type tfUnion struct {
+	Identifier fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"identifier"`
+	Name       types.String                                         `tfsdk:"name"`
+	ResourceID types.String                                         `tfsdk:"resource_id"`
+}
+
+var _ Union = tfUnion{}
+
+func (tfUnion) UnionMembers() []any {
+	return []any{
+		&awsUnionMemberIdentifier{},
+		&awsUnionMemberName{},
+		&awsUnionMemberResourceId{},
+		&awsUpdateUnionMemberName{},
+	}
+}
+
+type tfUnionMissingMember struct {
+	Name  types.String `tfsdk:"name"`
+	Other types.String `tfsdk:"other"`
+}
+
+var _ Union = tfUnionMissingMember{}
+
+func (tfUnionMissingMember) UnionMembers() []any {
+	return []any{
+		&awsUnionMemberName{},
+	}
+}
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberIdentifier struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberIdentifier) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberName struct {
+	Value string
+}
+
+func (*awsUnionMemberName) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberResourceId struct { // nosemgrep:ci.caps2-in-type-name
+	Value string
+}
+
+func (*awsUnionMemberResourceId) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUpdateUnion interface {
+	isAWSUpdateUnion()
+}
+
+type awsUpdateUnionMemberName struct {
+	Value string
+}
+
+func (*awsUpdateUnionMemberName) isAWSUpdateUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnknownUnionMember struct {
+	Tag   string
+	Value []byte
+}
+
+func (*awsUnknownUnionMember) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionSingle struct {
+	Field1 awsUnion
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnion
+}
+
+func testFlexAWSUnionPtr(v awsUnion) *awsUnion { // nosemgrep:ci.aws-in-func-name
+	return new(v)
+}
+
+func testFlexAWSUpdateUnionPtr(v awsUpdateUnion) *awsUpdateUnion { // nosemgrep:ci.aws-in-func-name
+	return new(v)
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var targetUnion awsUnion
+	var targetUpdateUnion awsUpdateUnion
+
+	testCases := autoFlexTestCases{
+		"top level structure member": {
+			Source: tfUnion{
+				Identifier: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+					Field1: types.StringValue("value1"),
+				}),
+				Name: types.StringNull(),
+			},
+			Target: &targetUnion,
+			WantTarget: testFlexAWSUnionPtr(&awsUnionMemberIdentifier{
+				Value: awsSingleStringValue{
+					Field1: "value1",
+				},
+			}),
+		},
+		"top level scalar member": {
+			Source: tfUnion{
+				Identifier: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				Name:       types.StringValue("value1"),
+			},
+			Target: &targetUnion,
+			WantTarget: testFlexAWSUnionPtr(&awsUnionMemberName{
+				Value: "value1",
+			}),
+		},
+		"member name differs in case": {
+			Source: tfUnion{
+				Identifier: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				Name:       types.StringNull(),
+				ResourceID: types.StringValue("value1"),
+			},
+			Target: &targetUnion,
+			WantTarget: testFlexAWSUnionPtr(&awsUnionMemberResourceId{
+				Value: "value1",
+			}),
+		},
+		"member of other union type": {
+			Source: tfUnion{
+				Identifier: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				Name:       types.StringValue("value1"),
+			},
+			Target: &targetUpdateUnion,
+			WantTarget: testFlexAWSUpdateUnionPtr(&awsUpdateUnionMemberName{
+				Value: "value1",
+			}),
+		},
+		"member does not implement target union type": {
+			Source: tfUnion{
+				Identifier: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+					Field1: types.StringValue("value1"),
+				}),
+				Name: types.StringNull(),
+			},
+			Target:        &targetUpdateUnion,
+			ExpectedDiags: diagAF2[*awsUnionMemberIdentifier, awsUpdateUnion](diagExpandedTypeDoesNotImplement),
+		},
+		"no member set": {
+			Source: tfUnion{
+				Identifier: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				Name:       types.StringNull(),
+			},
+			Target: &targetUnion,
+			ExpectedDiags: diag.Diagnostics{
+				diagExpandingUnionMemberCount(reflect.TypeFor[tfUnion](), 0),
+			},
+		},
+		"multiple members set": {
+			Source: tfUnion{
+				Identifier: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+					Field1: types.StringValue("value1"),
+				}),
+				Name: types.StringValue("value2"),
+			},
+			Target: &targetUnion,
+			ExpectedDiags: diag.Diagnostics{
+				diagExpandingUnionMemberCount(reflect.TypeFor[tfUnion](), 2),
+			},
+		},
+		"member has no type": {
+			Source: tfUnionMissingMember{
+				Name:  types.StringNull(),
+				Other: types.StringValue("value1"),
+			},
+			Target: &targetUnion,
+			ExpectedDiags: diag.Diagnostics{
+				diagExpandingNoUnionMember(reflect.TypeFor[tfUnionMissingMember](), "Other"),
+			},
+		},
+		"single list Source and single interface Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Identifier: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Name:       types.StringValue("value1"),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberName{
+					Value: "value1",
+				},
+			},
+		},
+		"list Source and slice interface Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Identifier: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+							Field1: types.StringValue("value1"),
+						}),
+						Name: types.StringNull(),
+					},
+					{
+						Identifier: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Name:       types.StringValue("value2"),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberIdentifier{
+						Value: awsSingleStringValue{
+							Field1: "value1",
+						},
+					},
+					&awsUnionMemberName{
+						Value: "value2",
+					},
+				},
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases, runChecks{})
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"top level structure member": {
+			Source: &awsUnionMemberIdentifier{
+				Value: awsSingleStringValue{
+					Field1: "value1",
+				},
+			},
+			Target: &tfUnion{},
+			WantTarget: &tfUnion{
+				Identifier: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+					Field1: types.StringValue("value1"),
+				}),
+				Name:       types.StringNull(),
+				ResourceID: types.StringNull(),
+			},
+		},
+		"top level scalar member": {
+			Source: &awsUnionMemberName{
+				Value: "value1",
+			},
+			Target: &tfUnion{},
+			WantTarget: &tfUnion{
+				Identifier: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				Name:       types.StringValue("value1"),
+				ResourceID: types.StringNull(),
+			},
+		},
+		"member name differs in case": {
+			Source: &awsUnionMemberResourceId{
+				Value: "value1",
+			},
+			Target: &tfUnion{},
+			WantTarget: &tfUnion{
+				Identifier: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				Name:       types.StringNull(),
+				ResourceID: types.StringValue("value1"),
+			},
+		},
+		"unknown member": {
+			Source: &awsUnknownUnionMember{
+				Tag: "unknown",
+			},
+			Target:        &tfUnion{},
+			ExpectedDiags: diagAF2[awsUnknownUnionMember, tfUnion](diagFlatteningNoUnionMemberField),
+		},
+		"nil interface Source and list Target": {
+			Source: awsUnionSingle{
+				Field1: nil,
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+		},
+		"single interface Source and single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberName{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Identifier: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Name:       types.StringValue("value1"),
+						ResourceID: types.StringNull(),
+					},
+				}),
+			},
+		},
+		"slice interface Source and list Target": {
+			Source: awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberIdentifier{
+						Value: awsSingleStringValue{
+							Field1: "value1",
+						},
+					},
+					&awsUnionMemberName{
+						Value: "value2",
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Identifier: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+							Field1: types.StringValue("value1"),
+						}),
+						Name:       types.StringNull(),
+						ResourceID: types.StringNull(),
+					},
+					{
+						Identifier: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Name:       types.StringValue("value2"),
+						ResourceID: types.StringNull(),
+					},
+				}),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases, runChecks{})
+}
@@ -4,16 +4,26 @@
 package flex
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"math/big"
+	"os"
 	"reflect"
 	"slices"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
 )
 
+const (
+	explainDiffEnvvar = "TF_AWS_AUTOFLEX_EXPLAIN_DIFF"
+)
+
 type Results struct {
 	hasChanges            bool
 	ignoredFieldNames     []string
@@ -116,3 +126,192 @@ func skippedFields() []string {
 		"Timeouts",
 	}
 }
+
+// ExplainDiffEnabled returns whether plan differences of AutoFlex-based resources are explained.
+func ExplainDiffEnabled() bool {
+	return os.Getenv(explainDiffEnvvar) != ""
+}
+
+// PlanDifference is an attribute whose planned value differs from its prior state value.
+type PlanDifference struct {
+	Path path.Path
+	// AWSValue is the prior state value, which was flattened from the AWS API response.
+	AWSValue    string
+	ConfigValue string
+	PlanValue   string
+	// Rule is the AutoFlex rule that produced AWSValue, or "" if unknown.
+	Rule string
+}
+
+// ExplainDiff returns the attributes whose planned values differ from their prior state values.
+// rules are the AutoFlex rules, keyed by attribute path, recorded by a FlattenTrace while the prior state was read.
+// Attributes that are unknown in the plan are not differences.
+func ExplainDiff(plan, state, config tftypes.Value, rules map[string]string) ([]PlanDifference, error) {
+	diffs, err := plan.Diff(state)
+	if err != nil {
+		return nil, err
+	}
+
+	var differences []PlanDifference
+	seen := make(map[string]bool)
+	for _, diff := range diffs {
+		planValue, stateValue := diff.Value1, diff.Value2
+
+		if planValue != nil && !planValue.IsKnown() {
+			continue
+		}
+		// Only report leaf values. Differences in collections and objects are reported by their elements and attributes.
+		if hasChildren(planValue) || hasChildren(stateValue) {
+			continue
+		}
+
+		attributePath, attributePathSteps := fromAttributePath(diff.Path)
+		if key := attributePath.String(); seen[key] {
+			continue
+		}
+		seen[attributePath.String()] = true
+
+		differences = append(differences, PlanDifference{
+			Path:        attributePath,
+			AWSValue:    formatValue(walkValue(state, attributePathSteps)),
+			ConfigValue: formatValue(walkValue(config, attributePathSteps)),
+			PlanValue:   formatValue(walkValue(plan, attributePathSteps)),
+			Rule:        lookupRule(attributePath, rules),
+		})
+	}
+
+	slices.SortFunc(differences, func(a, b PlanDifference) int {
+		return cmp.Compare(a.Path.String(), b.Path.String())
+	})
+
+	return differences, nil
+}
+
+// hasChildren returns whether a value is a non-empty collection or object.
+// tftypes reports the differences of its elements or attributes separately.
+func hasChildren(v *tftypes.Value) bool {
+	if v == nil || !v.IsKnown() || v.IsNull() {
+		return false
+	}
+
+	switch typ := v.Type(); {
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := v.As(&elements); err != nil {
+			return false
+		}
+		return len(elements) > 0
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := v.As(&elements); err != nil {
+			return false
+		}
+		return len(elements) > 0
+	default:
+		return false
+	}
+}
+
+// fromAttributePath converts a Terraform attribute path to a Plugin Framework path.
+// Paths into set elements are truncated at the set.
+func fromAttributePath(p *tftypes.AttributePath) (path.Path, *tftypes.AttributePath) {
+	result := path.Empty()
+	var steps []tftypes.AttributePathStep
+
+	for _, step := range p.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			result = result.AtName(string(step))
+		case tftypes.ElementKeyInt:
+			result = result.AtListIndex(int(step))
+		case tftypes.ElementKeyString:
+			result = result.AtMapKey(string(step))
+		default:
+			return result, tftypes.NewAttributePathWithSteps(steps)
+		}
+		steps = append(steps, step)
+	}
+
+	return result, tftypes.NewAttributePathWithSteps(steps)
+}
+
+func walkValue(v tftypes.Value, p *tftypes.AttributePath) *tftypes.Value {
+	got, _, err := tftypes.WalkAttributePath(v, p)
+	if err != nil {
+		return nil
+	}
+
+	if v, ok := got.(tftypes.Value); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func formatValue(v *tftypes.Value) string {
+	switch {
+	case v == nil:
+		return "(not set)"
+	case !v.IsKnown():
+		return "(known after apply)"
+	case v.IsNull():
+		return "null"
+	}
+
+	switch typ := v.Type(); {
+	case typ.Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err == nil {
+			return strconv.Quote(s)
+		}
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		if err := v.As(&n); err == nil {
+			return n.Text('g', -1)
+		}
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := v.As(&b); err == nil {
+			return strconv.FormatBool(b)
+		}
+	}
+
+	return v.String()
+}
+
+// lookupRule returns the rule recorded for the attribute path or its closest ancestor.
+// A single AWS structure is flattened into the first element of a list, so rules for nested attributes may be recorded without the element index.
+func lookupRule(p path.Path, rules map[string]string) string {
+	for {
+		if v, ok := rules[p.String()]; ok {
+			return v
+		}
+		if v, ok := rules[withoutFirstElementKeys(p).String()]; ok {
+			return v
+		}
+
+		if len(p.Steps()) == 0 {
+			return ""
+		}
+		p = p.ParentPath()
+	}
+}
+
+func withoutFirstElementKeys(p path.Path) path.Path {
+	result := path.Empty()
+
+	for _, step := range p.Steps() {
+		switch step := step.(type) {
+		case path.PathStepAttributeName:
+			result = result.AtName(string(step))
+		case path.PathStepElementKeyInt:
+			if step != 0 {
+				result = result.AtListIndex(int(step))
+			}
+		case path.PathStepElementKeyString:
+			result = result.AtMapKey(string(step))
+		}
+	}
+
+	return result
+}
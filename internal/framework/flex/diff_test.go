@@ -7,9 +7,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type testResourceData1 struct {
@@ -183,3 +187,144 @@ func TestDiffWithChangeOption(t *testing.T) {
 		})
 	}
 }
+
+type testFlattenTraceAWS struct {
+	KmsKeyId *string // nosemgrep:ci.caps2-in-type-name
+	Name     *string
+	Settings *testFlattenTraceAWSSettings
+}
+
+type testFlattenTraceAWSSettings struct {
+	Enabled bool
+}
+
+type testFlattenTraceModel struct {
+	KMSKeyID types.String                                                   `tfsdk:"kms_key_id"`
+	Name     types.String                                                   `tfsdk:"name" autoflex:",legacy"`
+	Settings fwtypes.ListNestedObjectValueOf[testFlattenTraceSettingsModel] `tfsdk:"settings"`
+}
+
+type testFlattenTraceSettingsModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+func TestFlattenTrace(t *testing.T) {
+	t.Parallel()
+
+	ctx := fwflex.NewFlattenTraceContext(context.Background())
+	apiObject := testFlattenTraceAWS{
+		KmsKeyId: aws.String("key"),
+		Name:     aws.String("name"),
+		Settings: &testFlattenTraceAWSSettings{
+			Enabled: true,
+		},
+	}
+	var data testFlattenTraceModel
+
+	if diags := fwflex.Flatten(ctx, apiObject, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	trace, ok := fwflex.FlattenTraceFromContext(ctx)
+	if !ok {
+		t.Fatal("FlattenTrace not found in context")
+	}
+
+	got := trace.Rules()
+	want := map[string]string{
+		"kms_key_id":       "AWS field KmsKeyId (*string) flattened to basetypes.StringValue by case-insensitive field name match",
+		names.AttrName:     "AWS field Name (*string) flattened to basetypes.StringValue by exact field name match with options legacy",
+		"settings":         "AWS field Settings (*flex_test.testFlattenTraceAWSSettings) flattened to types.ListNestedObjectValueOf[github.com/hashicorp/terraform-provider-aws/internal/framework/flex_test.testFlattenTraceSettingsModel] by exact field name match",
+		"settings.enabled": "AWS field Enabled (bool) flattened to basetypes.BoolValue by exact field name match",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestExplainDiff(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			names.AttrARN:  tftypes.String,
+			names.AttrName: tftypes.String,
+			"settings": tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						names.AttrEnabled: tftypes.Bool,
+					},
+				},
+			},
+		},
+	}
+	settingsType := objectType.AttributeTypes["settings"].(tftypes.List)
+	newValue := func(arn, name tftypes.Value, enabled ...bool) tftypes.Value {
+		var settings []tftypes.Value
+		for _, v := range enabled {
+			settings = append(settings, tftypes.NewValue(settingsType.ElementType, map[string]tftypes.Value{
+				names.AttrEnabled: tftypes.NewValue(tftypes.Bool, v),
+			}))
+		}
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			names.AttrARN:  arn,
+			names.AttrName: name,
+			"settings":     tftypes.NewValue(settingsType, settings),
+		})
+	}
+	rules := map[string]string{
+		names.AttrName:     "name rule",
+		"settings.enabled": "settings rule",
+	}
+
+	type difference struct {
+		Path, AWSValue, ConfigValue, PlanValue, Rule string
+	}
+
+	testCases := map[string]struct {
+		plan, state, config tftypes.Value
+		expected            []difference
+	}{
+		"no differences": {
+			plan:   newValue(tftypes.NewValue(tftypes.String, "arn"), tftypes.NewValue(tftypes.String, "test"), true),
+			state:  newValue(tftypes.NewValue(tftypes.String, "arn"), tftypes.NewValue(tftypes.String, "test"), true),
+			config: newValue(tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, "test"), true),
+		},
+		"unknown plan value": {
+			plan:   newValue(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, "test"), true),
+			state:  newValue(tftypes.NewValue(tftypes.String, "arn"), tftypes.NewValue(tftypes.String, "test"), true),
+			config: newValue(tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, "test"), true),
+		},
+		"differences": {
+			plan:   newValue(tftypes.NewValue(tftypes.String, "arn"), tftypes.NewValue(tftypes.String, "test"), true, true),
+			state:  newValue(tftypes.NewValue(tftypes.String, "arn"), tftypes.NewValue(tftypes.String, "TEST"), false),
+			config: newValue(tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, "test"), true, true),
+			expected: []difference{
+				{Path: names.AttrName, AWSValue: `"TEST"`, ConfigValue: `"test"`, PlanValue: `"test"`, Rule: "name rule"},
+				{Path: "settings[0].enabled", AWSValue: "false", ConfigValue: "true", PlanValue: "true", Rule: "settings rule"},
+				{Path: "settings[1].enabled", AWSValue: "(not set)", ConfigValue: "true", PlanValue: "true"},
+			},
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			differences, err := fwflex.ExplainDiff(test.plan, test.state, test.config, rules)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []difference
+			for _, v := range differences {
+				got = append(got, difference{Path: v.Path.String(), AWSValue: v.AWSValue, ConfigValue: v.ConfigValue, PlanValue: v.PlanValue, Rule: v.Rule})
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ListIDsFunc lists the IDs of all resources of a resource type.
+type ListIDsFunc func(context.Context, *conns.AWSClient) iter.Seq2[string, error]
+
+var _ Lister[listresource.InterceptorParamsSDK] = &listResourceFromSDKv2Resource{}
+
+// NewListResourceFromSDKv2Resource returns a list resource for an SDKv2 resource.
+// The state of each listed resource is set by the resource's Read function,
+// so the API calls made by Read are made for every listed resource.
+func NewListResourceFromSDKv2Resource(resource *schema.Resource, listIDs ListIDsFunc) inttypes.ListResourceForSDK {
+	l := listResourceFromSDKv2Resource{
+		listIDs: listIDs,
+	}
+	l.SetResourceSchema(resource)
+
+	return &l
+}
+
+type listResourceFromSDKv2Resource struct {
+	ListResourceWithSDKv2Resource
+	listIDs ListIDsFunc
+}
+
+func (l *listResourceFromSDKv2Resource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for id, err := range l.listIDs(ctx, awsClient) {
+			if err != nil {
+				yield(fwdiag.NewListResultErrorDiagnostic(err))
+				return
+			}
+
+			// Read the resource, making its API calls, even if the resource data isn't included.
+			rd := l.ResourceData()
+			rd.SetId(id)
+			if err := l.read(ctx, rd, awsClient); err != nil {
+				yield(fwdiag.NewListResultErrorDiagnostic(err))
+				return
+			}
+
+			// The resource was deleted after it was listed.
+			if rd.Id() == "" {
+				continue
+			}
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func (l *listResourceFromSDKv2Resource) read(ctx context.Context, d *schema.ResourceData, meta *conns.AWSClient) error {
+	resource := l.resourceSchema
+
+	if resource.ReadContext != nil || resource.ReadWithoutTimeout != nil {
+		var diags diag.Diagnostics
+
+		if resource.ReadContext != nil {
+			diags = resource.ReadContext(ctx, d, meta)
+		} else {
+			diags = resource.ReadWithoutTimeout(ctx, d, meta)
+		}
+
+		return sdkdiag.DiagnosticsError(diags)
+	}
+
+	return resource.Read(d, meta)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -86,6 +87,7 @@ var (
 	_ basetypes.StringValuable                   = (*IAMPolicy)(nil)
 	_ basetypes.StringValuableWithSemanticEquals = (*IAMPolicy)(nil)
 	_ xattr.ValidateableAttribute                = (*IAMPolicy)(nil)
+	_ function.ValidateableParameter             = (*IAMPolicy)(nil)
 )
 
 func IAMPolicyNull() IAMPolicy {
@@ -145,3 +147,18 @@ func (v IAMPolicy) ValidateAttribute(ctx context.Context, req xattr.ValidateAttr
 		)
 	}
 }
+
+func (v IAMPolicy) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IAM Policy Value: "+
+				"The provided value is not valid JSON string format (RFC 7159).\n\n"+
+				"Value: "+v.ValueString(),
+		)
+	}
+}
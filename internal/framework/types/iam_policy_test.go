@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
@@ -51,6 +52,46 @@ func TestIAMPolicyValidateAttribute(t *testing.T) {
 	}
 }
 
+func TestIAMPolicyValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         fwtypes.IAMPolicy
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: fwtypes.IAMPolicyUnknown(),
+		},
+		"null": {
+			val: fwtypes.IAMPolicyNull(),
+		},
+		"valid": {
+			val: fwtypes.IAMPolicyValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`),
+		},
+		"invalid": {
+			val:         fwtypes.IAMPolicyValue("not ok"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := function.ValidateParameterRequest{}
+			resp := function.ValidateParameterResponse{}
+
+			test.val.ValidateParameter(ctx, req, &resp)
+			if got := resp.Error != nil; got != test.expectError {
+				t.Errorf("resp.Error != nil = %t, want = %t", got, test.expectError)
+			}
+		})
+	}
+}
+
 func TestIAMPolicyStringSemanticEquals(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,369 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// iamPolicyVersions are the IAM policy language versions, oldest first.
+var iamPolicyVersions = []string{"2008-10-17", "2012-10-17"}
+
+// iamPolicyDocument is an IAM policy document whose JSON encoding is stable:
+// elements are marshaled in a fixed order, single-element arrays are marshaled as strings,
+// and Action, Resource, Principal and Condition values are sorted and deduplicated.
+// Elements that aren't modeled are preserved as-is.
+type iamPolicyDocument struct {
+	Version   string                `json:",omitempty"`
+	Id        string                `json:",omitempty"` //nolint:revive // Must match the IAM policy element name.
+	Statement []*iamPolicyStatement `json:",omitempty"`
+	elements  iamPolicyElements
+}
+
+type iamPolicyStatement struct {
+	Sid          string                                 `json:",omitempty"`
+	Effect       string                                 `json:",omitempty"`
+	Action       iamPolicyStrings                       `json:",omitempty"`
+	NotAction    iamPolicyStrings                       `json:",omitempty"`
+	Resource     iamPolicyStrings                       `json:",omitempty"`
+	NotResource  iamPolicyStrings                       `json:",omitempty"`
+	Principal    *iamPolicyPrincipal                    `json:",omitempty"`
+	NotPrincipal *iamPolicyPrincipal                    `json:",omitempty"`
+	Condition    map[string]map[string]iamPolicyStrings `json:",omitempty"`
+	elements     iamPolicyElements
+}
+
+// iamPolicyPrincipal is either a single principal string, such as the "*" wildcard,
+// or a mapping of principal types to identifiers.
+type iamPolicyPrincipal struct {
+	Value string
+	Types map[string]iamPolicyStrings
+}
+
+// iamPolicyStrings is an IAM policy element value that can be either a single value or an array of values.
+type iamPolicyStrings []string
+
+// iamPolicyElements are the IAM policy elements that aren't modeled, keyed by element name.
+type iamPolicyElements map[string]json.RawMessage
+
+// parseIAMPolicyDocument parses and normalizes the IAM policy document s.
+// Statements that are equivalent are removed. Of the statements with the same Sid, the last is kept.
+func parseIAMPolicyDocument(s string) (*iamPolicyDocument, error) {
+	doc := &iamPolicyDocument{}
+
+	if s := strings.TrimSpace(s); s == "" || s == "{}" {
+		return doc, nil
+	}
+
+	dec := json.NewDecoder(strings.NewReader(s))
+	if err := dec.Decode(doc); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, errors.New("unexpected content after policy document")
+	}
+
+	if doc.Version != "" && !slices.Contains(iamPolicyVersions, doc.Version) {
+		return nil, fmt.Errorf("unsupported Version (%s)", doc.Version)
+	}
+
+	statements := doc.Statement
+	doc.Statement = nil
+	for _, statement := range statements {
+		if statement == nil {
+			return nil, errors.New("null statement")
+		}
+
+		statement.normalize()
+		doc.addStatement(statement)
+	}
+
+	return doc, nil
+}
+
+// merge merges other into the document.
+// other's Id, if any, is adopted and other's Version, if later, replaces the document's Version.
+// Statements in other replace the document's statements with the same Sid.
+func (doc *iamPolicyDocument) merge(other *iamPolicyDocument) {
+	if other.Id != "" {
+		doc.Id = other.Id
+	}
+
+	if slices.Index(iamPolicyVersions, other.Version) > slices.Index(iamPolicyVersions, doc.Version) {
+		doc.Version = other.Version
+	}
+
+	if len(other.elements) > 0 {
+		if doc.elements == nil {
+			doc.elements = make(iamPolicyElements)
+		}
+		maps.Copy(doc.elements, other.elements)
+	}
+
+	for _, statement := range other.Statement {
+		doc.addStatement(statement)
+	}
+}
+
+func (doc *iamPolicyDocument) addStatement(statement *iamPolicyStatement) {
+	for i, existing := range doc.Statement {
+		if statement.Sid != "" && statement.Sid == existing.Sid {
+			doc.Statement[i] = statement
+			return
+		}
+
+		if statement.equivalent(existing) {
+			return
+		}
+	}
+
+	doc.Statement = append(doc.Statement, statement)
+}
+
+func (doc *iamPolicyDocument) String() (string, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func (doc iamPolicyDocument) MarshalJSON() ([]byte, error) {
+	type document iamPolicyDocument
+
+	return marshalIAMPolicyElements(document(doc), doc.elements)
+}
+
+func (doc *iamPolicyDocument) UnmarshalJSON(b []byte) error {
+	// Statement can be a single statement object or an array of statements.
+	type document iamPolicyDocument
+	var v struct {
+		document
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	elements, err := unmarshalIAMPolicyElements(b, "Version", "Id", "Statement")
+	if err != nil {
+		return err
+	}
+
+	*doc = iamPolicyDocument(v.document)
+	doc.elements = elements
+
+	if len(v.Statement) == 0 {
+		return nil
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(v.Statement), []byte("{")) {
+		statement := &iamPolicyStatement{}
+		if err := json.Unmarshal(v.Statement, statement); err != nil {
+			return err
+		}
+		doc.Statement = []*iamPolicyStatement{statement}
+
+		return nil
+	}
+
+	return json.Unmarshal(v.Statement, &doc.Statement)
+}
+
+func (s *iamPolicyStatement) normalize() {
+	for _, v := range []*iamPolicyStrings{&s.Action, &s.NotAction, &s.Resource, &s.NotResource} {
+		v.sort()
+	}
+
+	for _, v := range []*iamPolicyPrincipal{s.Principal, s.NotPrincipal} {
+		if v != nil {
+			for k, ids := range v.Types {
+				ids.sort()
+				v.Types[k] = ids
+			}
+		}
+	}
+
+	for _, m := range s.Condition {
+		for k, values := range m {
+			values.sort()
+			m[k] = values
+		}
+	}
+}
+
+// equivalent returns whether the statements are equivalent, as determined by verify.PolicyStringsEquivalent.
+func (s *iamPolicyStatement) equivalent(other *iamPolicyStatement) bool {
+	s1, err := (&iamPolicyDocument{Statement: []*iamPolicyStatement{s}}).String()
+	if err != nil {
+		return false
+	}
+
+	s2, err := (&iamPolicyDocument{Statement: []*iamPolicyStatement{other}}).String()
+	if err != nil {
+		return false
+	}
+
+	if s1 == s2 {
+		return true
+	}
+
+	// Unmodeled elements aren't compared by verify.PolicyStringsEquivalent.
+	if len(s.elements) > 0 || len(other.elements) > 0 {
+		return false
+	}
+
+	return verify.PolicyStringsEquivalent(s1, s2)
+}
+
+func (s iamPolicyStatement) MarshalJSON() ([]byte, error) {
+	type statement iamPolicyStatement
+
+	return marshalIAMPolicyElements(statement(s), s.elements)
+}
+
+func (s *iamPolicyStatement) UnmarshalJSON(b []byte) error {
+	type statement iamPolicyStatement
+	var v statement
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	elements, err := unmarshalIAMPolicyElements(b, "Sid", "Effect", "Action", "NotAction", "Resource", "NotResource", "Principal", "NotPrincipal", "Condition")
+	if err != nil {
+		return err
+	}
+
+	*s = iamPolicyStatement(v)
+	s.elements = elements
+
+	return nil
+}
+
+func (p iamPolicyPrincipal) MarshalJSON() ([]byte, error) {
+	if p.Types == nil {
+		return json.Marshal(p.Value)
+	}
+
+	return json.Marshal(p.Types)
+}
+
+func (p *iamPolicyPrincipal) UnmarshalJSON(b []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte(`"`)) {
+		return json.Unmarshal(b, &p.Value)
+	}
+
+	return json.Unmarshal(b, &p.Types)
+}
+
+func (v iamPolicyStrings) MarshalJSON() ([]byte, error) {
+	if len(v) == 1 {
+		return json.Marshal(v[0])
+	}
+
+	return json.Marshal([]string(v))
+}
+
+func (v *iamPolicyStrings) UnmarshalJSON(b []byte) error {
+	var values []any
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("[")) {
+		if err := unmarshalNumber(b, &values); err != nil {
+			return err
+		}
+	} else {
+		var value any
+		if err := unmarshalNumber(b, &value); err != nil {
+			return err
+		}
+		values = append(values, value)
+	}
+
+	// Policy values are strings, though booleans and numbers are accepted in conditions.
+	for _, value := range values {
+		switch value := value.(type) {
+		case string:
+			*v = append(*v, value)
+		case bool, json.Number:
+			*v = append(*v, fmt.Sprint(value))
+		default:
+			return fmt.Errorf("unsupported value type %T", value)
+		}
+	}
+
+	return nil
+}
+
+func (v *iamPolicyStrings) sort() {
+	slices.Sort(*v)
+	*v = slices.Compact(*v)
+}
+
+// marshalIAMPolicyElements marshals v, a JSON object, followed by elements in key order.
+func marshalIAMPolicyElements(v any, elements iamPolicyElements) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(elements) == 0 {
+		return b, err
+	}
+
+	var buf bytes.Buffer
+	buf.Write(b[:len(b)-1])
+	for _, k := range slices.Sorted(maps.Keys(elements)) {
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+
+		if err := json.Compact(&buf, elements[k]); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// unmarshalIAMPolicyElements returns the elements of the JSON object b other than the known elements.
+// Element names are matched case-insensitively, as encoding/json does.
+func unmarshalIAMPolicyElements(b []byte, known ...string) (iamPolicyElements, error) {
+	var elements iamPolicyElements
+	if err := json.Unmarshal(b, &elements); err != nil {
+		return nil, err
+	}
+
+	maps.DeleteFunc(elements, func(k string, _ json.RawMessage) bool {
+		return slices.ContainsFunc(known, func(s string) bool {
+			return strings.EqualFold(k, s)
+		})
+	})
+
+	if len(elements) == 0 {
+		return nil, nil
+	}
+
+	return elements, nil
+}
+
+func unmarshalNumber(b []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	return dec.Decode(v)
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+// apicallcoverage writes the AWS API operation coverage report for an
+// acceptance test run made with TF_ACC_API_COVERAGE_DIR set.
+//
+// Usage:
+//
+//	go run -tags generate ./internal/generate/apicallcoverage -dir /tmp/coverage
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall/coverage"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+var (
+	dir = flag.String("dir", "", "directory containing API call records (TF_ACC_API_COVERAGE_DIR)")
+	out = flag.String("out", "", "directory to write report.json and report.html to. Defaults to -dir.")
+)
+
+func main() {
+	g := common.NewGenerator()
+
+	flag.Parse()
+
+	if *dir == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *out == "" {
+		*out = *dir
+	}
+
+	services, err := services()
+	if err != nil {
+		g.Fatalf("reading service data: %s", err)
+	}
+
+	records, err := coverage.ReadRecords(*dir)
+	if err != nil {
+		g.Fatalf("%s", err)
+	}
+
+	g.Infof("Generating coverage report from %d API calls", len(records))
+
+	report := coverage.NewReport(services, records)
+
+	if err := writeFile(filepath.Join(*out, "report.json"), report.WriteJSON); err != nil {
+		g.Fatalf("%s", err)
+	}
+	if err := writeFile(filepath.Join(*out, "report.html"), report.WriteHTML); err != nil {
+		g.Fatalf("%s", err)
+	}
+}
+
+// services returns the operations of every AWS SDK for Go v2 client exposed by
+// the provider's AWSClient, keyed by the client's Smithy ServiceID.
+func services() ([]coverage.Service, error) {
+	serviceData, err := data.ReadAllServiceData()
+	if err != nil {
+		return nil, err
+	}
+
+	awsClientType := reflect.TypeFor[*conns.AWSClient]()
+
+	var services []coverage.Service
+	for _, l := range serviceData {
+		if l.Exclude() || l.NotImplemented() || !l.GenerateClient() || !l.IsClientSDKV2() {
+			continue
+		}
+
+		method, ok := awsClientType.MethodByName(l.ProviderNameUpper() + "Client")
+		if !ok || method.Type.NumOut() != 1 {
+			continue
+		}
+
+		services = append(services, coverage.Service{
+			ServiceID:  l.SDKID(),
+			Package:    l.ProviderPackage(),
+			Operations: coverage.ClientOperations(method.Type.Out(0)),
+		})
+	}
+
+	return services, nil
+}
+
+func writeFile(fileName string, write func(io.Writer) error) error {
+	f, err := os.Create(fileName)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", fileName, err)
+	}
+
+	if err := write(f); err != nil {
+		f.Close()
+		return fmt.Errorf("writing %s: %w", fileName, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing %s: %w", fileName, err)
+	}
+
+	return nil
+}
@@ -7,13 +7,16 @@ package main
 
 import (
 	_ "embed"
+	"errors"
 	"flag"
 	"fmt"
 	"go/ast"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/names/data"
 )
@@ -33,15 +36,28 @@ func usage() {
 }
 
 type TemplateData struct {
-	Parameters      string
-	AWSService      string
-	ServicePackage  string
-	InputPaginator  string
-	OutputPaginator string
-	Name            string
-	AWSName         string
-	ParamType       string
-	ResultType      string
+	Parameters        string
+	AWSService        string
+	ServicePackage    string
+	ProviderNameUpper string
+	InputPaginator    string
+	OutputPaginator   string
+	Name              string
+	AWSName           string
+	ParamType         string
+	ResultType        string
+	HasListResources  bool
+	ListResource      *ListResourceDatum
+}
+
+// ListResourceDatum describes a list resource generated from an SDK resource annotated with `@ListOperation`.
+type ListResourceDatum struct {
+	TypeName        string
+	FactoryName     string   // SDK resource factory, e.g. "resourceDashboard"
+	ListFactoryName string   // Generated list resource factory, e.g. "newDashboardResourceAsListResource"
+	ItemsField      string   // List operation output field containing the listed items
+	IDFields        []string // Item fields whose values make up the resource ID. Empty if the items are IDs
+	IDSep           string   // Go string literal separating the values of multiple ID fields
 }
 
 func main() {
@@ -85,20 +101,42 @@ func main() {
 
 	d := g.NewGoFileDestination(filename)
 
+	functions := strings.Split(*listOps, ",")
+	slices.Sort(functions)
+
+	// Look for SDK resources annotated with one of the list operations.
+	v := &visitor{
+		listResources: make(map[string]ListResourceDatum),
+	}
+	for file, err := range common.ScanDirectory(".") {
+		if err != nil {
+			g.Fatalf("%s", err.Error())
+		}
+
+		v.processFile(file.File())
+	}
+	if err := errors.Join(v.errs...); err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
 	templateData := TemplateData{
-		Parameters:      strings.Join(os.Args[1:], " "),
-		AWSService:      awsPkg,
-		ServicePackage:  servicePackage,
-		InputPaginator:  *inputPaginator,
-		OutputPaginator: *outputPaginator,
+		Parameters:        strings.Join(os.Args[1:], " "),
+		AWSService:        awsPkg,
+		ServicePackage:    servicePackage,
+		ProviderNameUpper: service.ProviderNameUpper(),
+		InputPaginator:    *inputPaginator,
+		OutputPaginator:   *outputPaginator,
+	}
+	for _, functionName := range functions {
+		if _, ok := v.listResources[functionName]; ok {
+			templateData.HasListResources = true
+		}
 	}
 
 	if err := d.BufferTemplate("header", headerTemplate, templateData); err != nil {
 		g.Fatalf("generating file (%s): %s", filename, err)
 	}
 
-	functions := strings.Split(*listOps, ",")
-	slices.Sort(functions)
 	for _, functionName := range functions {
 		function := pkg.FindFunction(functionName)
 		if function == nil {
@@ -141,6 +179,18 @@ func main() {
 		if err := d.BufferTemplate("function", functionTemplate, templateData); err != nil {
 			g.Fatalf("generating file (%s): %s", filename, err)
 		}
+
+		if listResource, ok := v.listResources[awsFunctionName]; ok {
+			g.Infof("  %s.%s", servicePackage, listResource.ListFactoryName)
+
+			templateData.ListResource = &listResource
+
+			if err := d.BufferTemplate("listresource", listResourceTemplate, templateData); err != nil {
+				g.Fatalf("generating file (%s): %s", filename, err)
+			}
+
+			templateData.ListResource = nil
+		}
 	}
 
 	if err := d.Write(); err != nil {
@@ -172,6 +222,103 @@ var headerTemplate string
 //go:embed function.gtpl
 var functionTemplate string
 
+//go:embed list_resource.gtpl
+var listResourceTemplate string
+
+var annotation = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
+
+type visitor struct {
+	errs          []error
+	listResources map[string]ListResourceDatum // Keyed by list operation
+}
+
+// processFile processes a single Go source file.
+func (v *visitor) processFile(file *ast.File) {
+	for _, decl := range file.Decls {
+		if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
+			v.processFuncDecl(funcDecl)
+		}
+	}
+}
+
+// processFuncDecl processes a single Go function.
+// A `@ListOperation` annotation on an SDK resource factory gives the list operation and the mapping of its output to resource IDs, e.g.
+//
+//	// @SDKResource("aws_cloudwatch_dashboard", name="Dashboard")
+//	// @ListOperation("ListDashboards", items="DashboardEntries", id="DashboardName")
+//
+// A composite ID lists the item fields separated by ";" and, optionally, the separator used in the resource ID, e.g.
+//
+//	// @ListOperation("ListThings", items="Things", id="ParentName;ThingName", idSep=":")
+func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
+	functionName := funcDecl.Name.Name
+	var typeName, listOp string
+	var d ListResourceDatum
+
+	for _, line := range funcDecl.Doc.List {
+		m := annotation.FindStringSubmatch(line.Text)
+		if len(m) == 0 {
+			continue
+		}
+
+		args, err := common.ParseArgs(m[3])
+		if err != nil {
+			v.errs = append(v.errs, fmt.Errorf("parsing annotation arguments in %s: %w", functionName, err))
+			continue
+		}
+
+		switch m[1] {
+		case "SDKResource":
+			if len(args.Positional) > 0 {
+				typeName = args.Positional[0]
+			}
+
+		case "ListOperation":
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("ListOperation missing required parameter: %s", functionName))
+				continue
+			}
+			listOp = args.Positional[0]
+
+			var ok bool
+			if d.ItemsField, ok = args.Keyword["items"]; !ok {
+				v.errs = append(v.errs, fmt.Errorf("ListOperation missing required parameter items: %s", functionName))
+				continue
+			}
+			if attr, ok := args.Keyword["id"]; ok {
+				d.IDFields = nil
+				for field := range strings.SplitSeq(attr, ";") {
+					d.IDFields = append(d.IDFields, strings.TrimSpace(field))
+				}
+			}
+			sep := ","
+			if attr, ok := args.Keyword["idSep"]; ok {
+				sep = attr
+			}
+			d.IDSep = strconv.Quote(sep)
+		}
+	}
+
+	if listOp == "" {
+		return
+	}
+
+	if typeName == "" {
+		v.errs = append(v.errs, fmt.Errorf("ListOperation is only supported on SDK resources: %s", functionName))
+		return
+	}
+
+	if _, ok := v.listResources[listOp]; ok {
+		v.errs = append(v.errs, fmt.Errorf("duplicate ListOperation (%s): %s", listOp, functionName))
+		return
+	}
+
+	d.TypeName = typeName
+	d.FactoryName = functionName
+	d.ListFactoryName = "new" + common.FirstUpper(strings.TrimPrefix(functionName, "resource")) + "ResourceAsListResource"
+	v.listResources[listOp] = d
+}
+
 func fixSomeInitialisms(s string) string {
 	replace := s
 
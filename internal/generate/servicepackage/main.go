@@ -230,6 +230,8 @@ type ResourceDatum struct {
 	regionOverrideEnabled             bool
 	RegionOverrideDeprecated          bool
 	ValidateRegionOverrideInPartition bool
+	AssumeRoleOverrideEnabled         bool
+	AssumeRoleOverrideDisabled        bool
 	TransparentTagging                bool
 	TagsIdentifierAttribute           string
 	TagsResourceType                  string
@@ -388,6 +390,18 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					}
 				}
 
+			case "AssumeRole":
+				d.AssumeRoleOverrideEnabled = true
+
+				if attr, ok := args.Keyword["overrideEnabled"]; ok {
+					if enabled, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid AssumeRole/overrideEnabled value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+					} else {
+						d.AssumeRoleOverrideEnabled = enabled
+						d.AssumeRoleOverrideDisabled = !enabled
+					}
+				}
+
 			case "Tags":
 				d.TransparentTagging = true
 
@@ -738,9 +752,9 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkListResources[typeName] = d
 				}
 
-			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "NoImport", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity":
+			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "AssumeRole", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "NoImport", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity":
 				// Handled above.
-			case "ArnFormat", "IdAttrFormat", "Testing":
+			case "ArnFormat", "IdAttrFormat", "ListOperation", "Testing":
 				// Ignored.
 			default:
 				v.g.Warnf("unknown annotation: %s", annotationName)
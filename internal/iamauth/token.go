@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package iamauth builds the IAM authentication tokens used to connect to ElastiCache and MemoryDB caches.
+package iamauth
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+const (
+	// TokenExpiry is the validity period of an IAM authentication token.
+	TokenExpiry = 15 * time.Minute
+
+	// emptyPayloadHash is the SHA-256 hash of an empty request body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// BuildToken signs an IAM authentication token for the specified user and cache.
+// signingName is the SigV4 signing name of the service, e.g. "elasticache".
+// Any additional query parameters, such as the cache's resource type, are included in the signed request.
+func BuildToken(ctx context.Context, signingName, cacheName, userName, region string, credentialsProvider aws.CredentialsProvider, signingTime time.Time, params url.Values) (string, error) {
+	query := url.Values{
+		"Action":        []string{"connect"},
+		"User":          []string{userName},
+		"X-Amz-Expires": []string{strconv.Itoa(int(TokenExpiry.Seconds()))},
+	}
+	maps.Copy(query, params)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, (&url.URL{Scheme: "http", Host: cacheName, Path: "/", RawQuery: query.Encode()}).String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	credentials, err := credentialsProvider.Retrieve(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	uri, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, request, emptyPayloadHash, signingName, region, signingTime)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(uri, "http://"), nil
+}
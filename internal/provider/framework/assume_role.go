@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func validateInContextAssumeRoleARNInPartition(ctx context.Context, c awsClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := c.ValidateInContextAssumeRoleARNInPartition(ctx); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrAssumeRoleARN), "Invalid Assume Role ARN Value", err.Error())
+	}
+
+	return diags
+}
+
+// splitImportIDAssumeRoleARN splits any "@<IAM role ARN>" suffix from an import ID.
+func splitImportIDAssumeRoleARN(id string) (string, string, bool) {
+	if matches := regexache.MustCompile(`^(.+)@(` + inttypes.CanonicalIAMRoleARNPatternNoAnchors + `)$`).FindStringSubmatch(id); len(matches) == 3 {
+		return matches[1], matches[2], true
+	}
+
+	return id, "", false
+}
+
+// identityAssumeRoleARN returns the value of the `assume_role_arn` identity attribute.
+func identityAssumeRoleARN(ctx context.Context, identity *tfsdk.ResourceIdentity) (types.String, diag.Diagnostics) {
+	var roleARN types.String
+	diags := identity.GetAttribute(ctx, path.Root(names.AttrAssumeRoleARN), &roleARN)
+
+	return roleARN, diags
+}
+
+// importGetAttribute returns a getAttributeFunc that reads the top-level `assume_role_arn` attribute from any import ID suffix
+// or, when importing by identity, from the resource identity.
+// All other attributes are read as null.
+func importGetAttribute(request resource.ImportStateRequest) getAttributeFunc {
+	return func(ctx context.Context, p path.Path, target any) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if v, ok := target.(*types.String); ok {
+			*v = types.StringNull()
+			if p.Equal(path.Root(names.AttrAssumeRoleARN)) {
+				if request.ID != "" {
+					if _, roleARN, ok := splitImportIDAssumeRoleARN(request.ID); ok {
+						*v = types.StringValue(roleARN)
+					}
+				} else if request.Identity != nil {
+					roleARN, d := identityAssumeRoleARN(ctx, request.Identity)
+					diags.Append(d...)
+					if diags.HasError() {
+						return diags
+					}
+
+					*v = roleARN
+				}
+			}
+		}
+
+		return diags
+	}
+}
+
+type resourceInjectAssumeRoleARNAttributeInterceptor struct{}
+
+func (r resourceInjectAssumeRoleARNAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[resource.SchemaRequest, resource.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[names.AttrAssumeRoleARN]; !ok {
+			// Inject a top-level "assume_role_arn" attribute.
+			response.Schema.Attributes[names.AttrAssumeRoleARN] = resourceattribute.AssumeRoleARN()
+		}
+	}
+}
+
+// resourceInjectAssumeRoleARNAttribute injects a top-level "assume_role_arn" attribute into a resource's schema.
+func resourceInjectAssumeRoleARNAttribute() resourceSchemaInterceptor {
+	return &resourceInjectAssumeRoleARNAttributeInterceptor{}
+}
+
+type resourceValidateAssumeRoleARNInterceptor struct{}
+
+func (r resourceValidateAssumeRoleARNInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	switch when := opts.when; when {
+	case Before:
+		opts.response.Diagnostics.Append(validateInContextAssumeRoleARNInPartition(ctx, c)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// resourceValidateAssumeRoleARN validates that the value of the top-level `assume_role_arn` attribute is in the configured AWS partition.
+func resourceValidateAssumeRoleARN() resourceModifyPlanInterceptor {
+	return &resourceValidateAssumeRoleARNInterceptor{}
+}
+
+type resourceImportAssumeRoleARNInterceptor struct{}
+
+func (r resourceImportAssumeRoleARNInterceptor) importState(ctx context.Context, opts interceptorOptions[resource.ImportStateRequest, resource.ImportStateResponse]) {
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// Import ID optionally ends with "@<IAM role ARN>", after any "@<region>".
+		if id, roleARN, ok := splitImportIDAssumeRoleARN(request.ID); ok {
+			request.ID = id
+			opts.response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrAssumeRoleARN), roleARN)...)
+			if opts.response.Diagnostics.HasError() {
+				return
+			}
+		} else if request.ID == "" && request.Identity != nil {
+			// Import by identity. The identity optionally records the IAM role.
+			roleARN, diags := identityAssumeRoleARN(ctx, request.Identity)
+			opts.response.Diagnostics.Append(diags...)
+			if opts.response.Diagnostics.HasError() {
+				return
+			}
+
+			if !roleARN.IsNull() {
+				opts.response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrAssumeRoleARN), roleARN)...)
+				if opts.response.Diagnostics.HasError() {
+					return
+				}
+			}
+		}
+	}
+}
+
+// resourceImportAssumeRoleARN sets the value of the top-level `assume_role_arn` attribute during import, from the import ID or resource identity.
+func resourceImportAssumeRoleARN() resourceImportStateInterceptor {
+	return &resourceImportAssumeRoleARNInterceptor{}
+}
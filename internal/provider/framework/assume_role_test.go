@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/identity"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResourceImportAssumeRoleARNInterceptor_ImportState(t *testing.T) {
+	t.Parallel()
+
+	const roleARN = "arn:aws:iam::123456789012:role/example" //lintignore:AWSAT005
+
+	ctx := context.Background()
+	client := mockClient{}
+	icpt := resourceImportAssumeRoleARNInterceptor{}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName:          schema.StringAttribute{Required: true},
+			names.AttrRegion:        resourceattribute.Region(),
+			names.AttrAssumeRoleARN: resourceattribute.AssumeRoleARN(),
+		},
+	}
+
+	tests := map[string]struct {
+		importID          string
+		expectedID        string
+		expectedRoleARN   string
+		expectedRoleIsSet bool
+	}{
+		"no suffix": {
+			importID:   "example",
+			expectedID: "example",
+		},
+		"Region suffix": {
+			importID:   "example@us-west-2", //lintignore:AWSAT003
+			expectedID: "example@us-west-2", //lintignore:AWSAT003
+		},
+		"IAM role ARN suffix": {
+			importID:          "example@" + roleARN,
+			expectedID:        "example",
+			expectedRoleARN:   roleARN,
+			expectedRoleIsSet: true,
+		},
+		"Region and IAM role ARN suffixes": {
+			importID:          "example@us-west-2@" + roleARN, //lintignore:AWSAT003
+			expectedID:        "example@us-west-2",            //lintignore:AWSAT003
+			expectedRoleARN:   roleARN,
+			expectedRoleIsSet: true,
+		},
+		"ID containing at sign": {
+			importID:          "user@example.com@" + roleARN,
+			expectedID:        "user@example.com",
+			expectedRoleARN:   roleARN,
+			expectedRoleIsSet: true,
+		},
+	}
+
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ImportStateRequest{ID: tc.importID}
+			resp := resource.ImportStateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+					Schema: s,
+				},
+			}
+
+			icpt.importState(ctx, interceptorOptions[resource.ImportStateRequest, resource.ImportStateResponse]{
+				c:        client,
+				request:  &req,
+				response: &resp,
+				when:     Before,
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diags: %s", resp.Diagnostics)
+			}
+
+			if got, want := req.ID, tc.expectedID; got != want {
+				t.Errorf("expected ID %q, got %q", want, got)
+			}
+
+			if tc.expectedRoleIsSet {
+				if got, want := getStateAttributeValue(ctx, t, resp.State, path.Root(names.AttrAssumeRoleARN)), tc.expectedRoleARN; got != want {
+					t.Errorf("expected assume_role_arn %q, got %q", want, got)
+				}
+			} else if !resp.State.Raw.IsNull() {
+				t.Errorf("expected State.Raw to stay null, got %#v", resp.State.Raw)
+			}
+		})
+	}
+}
+
+func TestResourceImportAssumeRoleARNInterceptor_ImportStateIdentity(t *testing.T) {
+	t.Parallel()
+
+	const roleARN = "arn:aws:iam::123456789012:role/example" //lintignore:AWSAT005
+
+	ctx := context.Background()
+	client := mockClient{}
+	icpt := resourceImportAssumeRoleARNInterceptor{}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName:          schema.StringAttribute{Required: true},
+			names.AttrAssumeRoleARN: resourceattribute.AssumeRoleARN(),
+		},
+	}
+	identitySpec := inttypes.GlobalSingleParameterIdentity(inttypes.StringIdentityAttribute(names.AttrName, true)).WithAssumeRoleARN()
+	identitySchema := identity.NewIdentitySchema(identitySpec)
+
+	tests := map[string]struct {
+		identityRoleARN   *string
+		expectedRoleIsSet bool
+	}{
+		"no IAM role ARN": {},
+		"IAM role ARN": {
+			identityRoleARN:   aws.String(roleARN),
+			expectedRoleIsSet: true,
+		},
+	}
+
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			resourceIdentity := emtpyIdentityFromSchema(ctx, &identitySchema)
+			if diags := resourceIdentity.SetAttribute(ctx, path.Root(names.AttrName), "example"); diags.HasError() {
+				t.Fatalf("unexpected diags: %s", diags)
+			}
+			if v := tc.identityRoleARN; v != nil {
+				if diags := resourceIdentity.SetAttribute(ctx, path.Root(names.AttrAssumeRoleARN), *v); diags.HasError() {
+					t.Fatalf("unexpected diags: %s", diags)
+				}
+			}
+
+			req := resource.ImportStateRequest{Identity: resourceIdentity}
+			resp := resource.ImportStateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+					Schema: s,
+				},
+			}
+
+			var target types.String
+			if diags := importGetAttribute(req)(ctx, path.Root(names.AttrAssumeRoleARN), &target); diags.HasError() {
+				t.Fatalf("unexpected diags: %s", diags)
+			}
+			if got, want := target.ValueString(), aws.ToString(tc.identityRoleARN); got != want {
+				t.Errorf("expected context assume_role_arn %q, got %q", want, got)
+			}
+
+			icpt.importState(ctx, interceptorOptions[resource.ImportStateRequest, resource.ImportStateResponse]{
+				c:        client,
+				request:  &req,
+				response: &resp,
+				when:     Before,
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diags: %s", resp.Diagnostics)
+			}
+
+			if tc.expectedRoleIsSet {
+				if got, want := getStateAttributeValue(ctx, t, resp.State, path.Root(names.AttrAssumeRoleARN)), roleARN; got != want {
+					t.Errorf("expected assume_role_arn %q, got %q", want, got)
+				}
+			} else if !resp.State.Raw.IsNull() {
+				t.Errorf("expected State.Raw to stay null, got %#v", resp.State.Raw)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+const (
+	// autoFlexRulesPrivateStateKey is the private state key of the AutoFlex rules recorded during Read.
+	autoFlexRulesPrivateStateKey = "autoflex_rules"
+)
+
+// resourceExplainAutoFlexDiff explains the plan differences of AutoFlex-based resources.
+// The AutoFlex rules used to flatten the API response during Read are kept in private state.
+func resourceExplainAutoFlexDiff() interface {
+	resourceCRUDInterceptor
+	resourceModifyPlanInterceptor
+} {
+	return &explainAutoFlexDiffInterceptor{}
+}
+
+type explainAutoFlexDiffInterceptor struct {
+	resourceNoOpCRUDInterceptor
+}
+
+func (r explainAutoFlexDiffInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() || response.Private == nil {
+			return
+		}
+
+		trace, ok := fwflex.FlattenTraceFromContext(ctx)
+		if !ok {
+			return
+		}
+
+		// An empty value removes any rules from a previous Read.
+		var value []byte
+		if rules := trace.Rules(); len(rules) > 0 {
+			v, err := tfjson.EncodeToBytes(rules)
+			if err != nil {
+				opts.response.Diagnostics.AddError("encoding AutoFlex rules", err.Error())
+				return
+			}
+			value = v
+		}
+
+		opts.response.Diagnostics.Append(response.Private.SetKey(ctx, autoFlexRulesPrivateStateKey, value)...)
+	}
+}
+
+func (r explainAutoFlexDiffInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case After:
+		// If the entire plan is null, the resource is planned for destruction.
+		// If the entire state is null, the resource is planned for creation.
+		if response.Plan.Raw.IsNull() || request.State.Raw.IsNull() || request.Private == nil {
+			return
+		}
+
+		value, diags := request.Private.GetKey(ctx, autoFlexRulesPrivateStateKey)
+		opts.response.Diagnostics.Append(diags...)
+		if diags.HasError() || len(value) == 0 {
+			return
+		}
+
+		var rules map[string]string
+		if err := tfjson.DecodeFromBytes(value, &rules); err != nil {
+			opts.response.Diagnostics.AddWarning("decoding AutoFlex rules", err.Error())
+			return
+		}
+
+		differences, err := fwflex.ExplainDiff(response.Plan.Raw, request.State.Raw, request.Config.Raw, rules)
+		if err != nil {
+			opts.response.Diagnostics.AddWarning("explaining AutoFlex plan differences", err.Error())
+			return
+		}
+
+		if len(differences) == 0 {
+			return
+		}
+
+		var detail strings.Builder
+		detail.WriteString("The following attributes differ from the values flattened from the AWS API response when the resource was last read:\n")
+		for _, v := range differences {
+			rule := v.Rule
+			if rule == "" {
+				rule = "(not flattened by AutoFlex)"
+			}
+			fmt.Fprintf(&detail, "\n%s\n  AWS value:     %s\n  Config value:  %s\n  Planned value: %s\n  AutoFlex rule: %s\n", v.Path, v.AWSValue, v.ConfigValue, v.PlanValue, rule)
+		}
+
+		opts.response.Diagnostics.AddWarning("AutoFlex Plan Differences", detail.String())
+	}
+}
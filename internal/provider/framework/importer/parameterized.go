@@ -100,7 +100,7 @@ func MultipleParameterized(ctx context.Context, client AWSClient, request resour
 
 		for _, attr := range identitySpec.Attributes {
 			switch attr.Name() {
-			case names.AttrAccountID, names.AttrRegion:
+			case names.AttrAccountID, names.AttrAssumeRoleARN, names.AttrRegion:
 				// Do nothing
 
 			default:
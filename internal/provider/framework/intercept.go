@@ -28,6 +28,7 @@ type awsClient interface {
 	Partition(context.Context) string
 	ServicePackage(_ context.Context, name string) conns.ServicePackage
 	TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig
+	ValidateInContextAssumeRoleARNInPartition(ctx context.Context) error
 	ValidateInContextRegionInPartition(ctx context.Context) error
 	AwsConfig(context.Context) aws.Config
 }
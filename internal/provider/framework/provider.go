@@ -182,6 +182,12 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 					`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 			},
+			"tag_policy_file": schema.StringAttribute{
+				Optional: true,
+				Description: `The path of a local AWS Organizations tag policy document to enforce instead of the organization's effective tag policy. ` +
+					`In addition to required tag keys, the document's tag key capitalization and allowed tag values are enforced for the resource types in its "enforced_for" values. ` +
+					`Can also be configured with the ` + tftags.TagPolicyFileEnvVar + ` environment variable.`,
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -327,6 +333,41 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"rate_limits": schema.ListNestedBlock{
+				Description: "Client-side rate limits to apply to the AWS API calls made to individual services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_in_flight": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of concurrent in-flight requests to the service.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests per second sent to the service, across all Regions.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, named as in the `endpoints` configuration block, e.g. `route53`.",
+						},
+					},
+				},
+			},
+			"tag_policy_remediation": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to add required tags missing from resources when tag policy compliance is enforced.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Values for required tag keys, added to resources whose configuration and default tags lack the key. " +
+								"Values are Go templates that can reference `.AccountID`, `.Partition`, `.Region` and `.ResourceType`.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -397,6 +438,8 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 		tffunction.NewUserAgentFunction,
 	}
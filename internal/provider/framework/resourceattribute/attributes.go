@@ -6,10 +6,29 @@ package resourceattribute
 import (
 	"sync"
 
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+var AssumeRoleARN = sync.OnceValue(func() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: names.ResourceTopLevelAssumeRoleARNAttributeDescription,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(regexache.MustCompile(inttypes.CanonicalIAMRoleARNPattern), "must be an IAM role ARN"),
+		},
+	}
+})
+
 var Region = sync.OnceValue(func() schema.StringAttribute {
 	return schema.StringAttribute{
 		Optional:    true,
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -87,7 +88,7 @@ func (r tagsResourceInterceptor) create(ctx context.Context, opts interceptorOpt
 		return
 	}
 
-	sp, _, _, _, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
+	sp, _, _, typeName, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
 	if !ok {
 		return
 	}
@@ -100,8 +101,14 @@ func (r tagsResourceInterceptor) create(ctx context.Context, opts interceptorOpt
 			return
 		}
 
+		defaultTagsConfig, _, err := interceptors.DefaultTagsConfig(ctx, c, typeName)
+		if err != nil {
+			opts.response.Diagnostics.AddError("remediating required tags", err.Error())
+			return
+		}
+
 		// Merge the resource's configured tags with any provider configured default_tags.
-		tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags))
+		tags := defaultTagsConfig.MergeTags(tftags.New(ctx, planTags))
 		// Remove system tags.
 		tags = tags.IgnoreSystem(sp.ServicePackageName())
 		tagsInContext.TagsIn = option.Some(tags)
@@ -124,7 +131,7 @@ func (r tagsResourceInterceptor) read(ctx context.Context, opts interceptorOptio
 		return
 	}
 
-	sp, serviceName, resourceName, _, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
+	sp, serviceName, resourceName, typeName, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
 	if !ok {
 		return
 	}
@@ -151,12 +158,14 @@ func (r tagsResourceInterceptor) read(ctx context.Context, opts interceptorOptio
 
 		apiTags := tagsInContext.TagsOut.UnwrapOrDefault()
 
+		defaultTagsConfig := interceptors.ReadDefaultTagsConfig(ctx, c, typeName)
+
 		// AWS APIs often return empty lists of tags when none have been configured.
 		var stateTags tftags.Map
 		response.State.GetAttribute(ctx, path.Root(names.AttrTags), &stateTags)
 		// Remove any provider configured ignore_tags and system tags from those returned from the service API.
 		// The resource's configured tags do not include any provider configured default_tags.
-		if v := apiTags.IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx)).ResolveDuplicatesFramework(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), stateTags, &opts.response.Diagnostics).Map(); len(v) > 0 {
+		if v := apiTags.IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx)).ResolveDuplicatesFramework(ctx, defaultTagsConfig, c.IgnoreTagsConfig(ctx), stateTags, &opts.response.Diagnostics).Map(); len(v) > 0 {
 			stateTags = tftags.NewMapFromMapValue(fwflex.FlattenFrameworkStringValueMapLegacy(ctx, v))
 		}
 		opts.response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTags), &stateTags)...)
@@ -180,7 +189,7 @@ func (r tagsResourceInterceptor) update(ctx context.Context, opts interceptorOpt
 		return
 	}
 
-	sp, serviceName, resourceName, _, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
+	sp, serviceName, resourceName, typeName, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
 	if !ok {
 		return
 	}
@@ -193,8 +202,14 @@ func (r tagsResourceInterceptor) update(ctx context.Context, opts interceptorOpt
 			return
 		}
 
+		defaultTagsConfig, _, err := interceptors.DefaultTagsConfig(ctx, c, typeName)
+		if err != nil {
+			opts.response.Diagnostics.AddError("remediating required tags", err.Error())
+			return
+		}
+
 		// Merge the resource's configured tags with any provider configured default_tags.
-		tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags))
+		tags := defaultTagsConfig.MergeTags(tftags.New(ctx, planTags))
 		// Remove system tags.
 		tags = tags.IgnoreSystem(sp.ServicePackageName())
 		tagsInContext.TagsIn = option.Some(tags)
@@ -242,7 +257,15 @@ func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, opts intercepto
 		}
 
 		if planTags.IsWhollyKnown() {
-			allTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags)).IgnoreConfig(c.IgnoreTagsConfig(ctx))
+			_, _, _, typeName, _, _ := interceptors.InfoFromContext(ctx, c) //nolint:dogsled // legitimate use as-is, signature to be refactored
+			// Any required tags remediated by the tag policy configuration are shown in the plan as part of `tags_all`.
+			defaultTagsConfig, _, err := interceptors.DefaultTagsConfig(ctx, c, typeName)
+			if err != nil {
+				opts.response.Diagnostics.AddError("remediating required tags", err.Error())
+				return
+			}
+
+			allTags := defaultTagsConfig.MergeTags(tftags.New(ctx, planTags)).IgnoreConfig(c.IgnoreTagsConfig(ctx))
 			opts.response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), fwflex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
 		} else {
 			opts.response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
@@ -283,7 +306,7 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 		return
 	}
 	reqTags, ok := policy.RequiredTags[typeName]
-	if !ok {
+	if !ok && len(policy.Rules[typeName]) == 0 {
 		return
 	}
 
@@ -305,8 +328,14 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 			return
 		}
 
-		allPlanTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags))
-		allStateTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, stateTags))
+		defaultTagsConfig, remediatedTags, err := interceptors.DefaultTagsConfig(ctx, c, typeName)
+		if err != nil {
+			opts.response.Diagnostics.AddError("remediating required tags", err.Error())
+			return
+		}
+
+		allPlanTags := defaultTagsConfig.MergeTags(tftags.New(ctx, planTags))
+		allStateTags := defaultTagsConfig.MergeTags(tftags.New(ctx, stateTags))
 
 		isCreate := request.State.Raw.IsNull()
 		hasTagsChange := !allPlanTags.Equal(allStateTags)
@@ -315,21 +344,28 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 			return
 		}
 
-		if allPlanTags.ContainsAllKeys(reqTags) {
-			return
+		if injected := remediatedTags.Removed(tftags.New(ctx, planTags)); len(injected) > 0 {
+			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTagsAll),
+				"Required Tags Remediated",
+				fmt.Sprintf("The following tags were added to satisfy an organizational tag policy for %s: %v", typeName, injected.Map()),
+			)
 		}
 
-		missing := reqTags.Removed(allPlanTags).Keys()
-		slices.Sort(missing)
-
-		summary := "Missing Required Tags"
-		detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+		report := func(summary, detail string) {
+			switch policy.Severity {
+			case "warning":
+				opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
+			default:
+				opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+			}
+		}
 
-		switch policy.Severity {
-		case "warning":
-			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
-		default:
-			opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+		if missing := reqTags.Removed(allPlanTags).Keys(); len(missing) > 0 {
+			slices.Sort(missing)
+			report("Missing Required Tags", fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing))
+		}
+		if violations := policy.Violations(typeName, allPlanTags); len(violations) > 0 {
+			report("Noncompliant Tags", fmt.Sprintf("An organizational tag policy does not allow the following tags for %s: %s", typeName, strings.Join(violations, "; ")))
 		}
 	}
 }
@@ -47,6 +47,38 @@ func (c mockRequiredTagsClient) TagPolicyConfig(ctx context.Context) *tftags.Tag
 	}
 }
 
+type mockRemediatedTagsClient struct {
+	mockRequiredTagsClient
+}
+
+func (c mockRemediatedTagsClient) Partition(context.Context) string {
+	return "aws"
+}
+
+func (c mockRemediatedTagsClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig {
+	policy := c.mockRequiredTagsClient.TagPolicyConfig(ctx)
+	policy.RemediationTags, _ = tftags.NewRemediationTags(map[string]string{
+		"foo": "{{ .AccountID }}",
+	})
+
+	return policy
+}
+
+type mockTagRulesClient struct {
+	mockRequiredTagsClient
+}
+
+func (c mockTagRulesClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig {
+	return &tftags.TagPolicyConfig{
+		Severity: "error",
+		Rules: map[string][]tftags.TagPolicyRule{
+			"aws_test": {
+				{Key: "foo", Values: []string{"a*"}},
+			},
+		},
+	}
+}
+
 type mockServicePackage struct{}
 
 func (sp mockServicePackage) FrameworkDataSources(context.Context) []*inttypes.ServicePackageFrameworkDataSource {
@@ -74,7 +106,7 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 	ctx := t.Context()
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "test", "aws_test", "")
+		ctx = conns.NewResourceContext(ctx, "Test", "test", "aws_test", "", "")
 		if v, ok := meta.(awsClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx), v.TagPolicyConfig(ctx))
 		}
@@ -127,6 +159,15 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 	}
 	rawValUnknown := tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), attrsUnknown)
 
+	// Noncompliant tags
+	attrsNoncompliant := map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "test"),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"FOO": tftypes.NewValue(tftypes.String, "b"),
+		}),
+	}
+	rawValNoncompliant := tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), attrsNoncompliant)
+
 	tests := []struct {
 		name      string
 		opts      interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]
@@ -198,6 +239,111 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 			),
 			},
 		},
+		{
+			name: "create, partial tags, remediated",
+			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c: mockRemediatedTagsClient{mockRequiredTagsClient{mockClient{accountID: "123456789012"}}},
+				request: &resource.ModifyPlanRequest{
+					Config: tfsdk.Config{
+						Raw:    rawValPartial,
+						Schema: resourceSchema,
+					},
+					State: tfsdk.State{
+						Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil), // Raw state is null on creation
+						Schema: resourceSchema,
+					},
+					Plan: tfsdk.Plan{
+						Raw:    rawValPartial,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tfsdk.Plan{
+						Raw:    rawValPartial,
+						Schema: resourceSchema,
+					},
+				},
+				when: Before,
+			},
+			wantDiags: diag.Diagnostics{diag.NewAttributeWarningDiagnostic(
+				path.Root(names.AttrTagsAll),
+				"Required Tags Remediated",
+				"The following tags were added to satisfy an organizational tag policy for aws_test: map[foo:123456789012]",
+			),
+			},
+		},
+		{
+			name: "create, missing tags, partially remediated",
+			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c: mockRemediatedTagsClient{mockRequiredTagsClient{mockClient{accountID: "123456789012"}}},
+				request: &resource.ModifyPlanRequest{
+					Config: tfsdk.Config{
+						Raw:    rawVal,
+						Schema: resourceSchema,
+					},
+					State: tfsdk.State{
+						Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil), // Raw state is null on creation
+						Schema: resourceSchema,
+					},
+					Plan: tfsdk.Plan{
+						Raw:    rawVal,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tfsdk.Plan{
+						Raw:    rawVal,
+						Schema: resourceSchema,
+					},
+				},
+				when: Before,
+			},
+			wantDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root(names.AttrTagsAll),
+					"Required Tags Remediated",
+					"The following tags were added to satisfy an organizational tag policy for aws_test: map[foo:123456789012]",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root(names.AttrTags),
+					"Missing Required Tags",
+					"An organizational tag policy requires the following tags for aws_test: [bar]",
+				),
+			},
+		},
+		{
+			name: "create, noncompliant tags",
+			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c: mockTagRulesClient{},
+				request: &resource.ModifyPlanRequest{
+					Config: tfsdk.Config{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+					State: tfsdk.State{
+						Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil), // Raw state is null on creation
+						Schema: resourceSchema,
+					},
+					Plan: tfsdk.Plan{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tfsdk.Plan{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+				},
+				when: Before,
+			},
+			wantDiags: diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+				path.Root(names.AttrTags),
+				"Noncompliant Tags",
+				`An organizational tag policy does not allow the following tags for aws_test: tag key "FOO" must be capitalized as "foo"; tag "FOO" value "b" is not one of the allowed values ["a*"]`,
+			),
+			},
+		},
 		{
 			name: "create, required tags",
 			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
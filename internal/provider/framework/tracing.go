@@ -0,0 +1,28 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startHandlerSpan starts the tracing span for a CRUD handler invocation, including its interceptors.
+// AWS API operations and waiters invoked by the handler are recorded as child spans.
+func startHandlerSpan(ctx context.Context, servicePackageName, typeName, operation string) (context.Context, trace.Span) {
+	return tracing.StartSpan(ctx, tracing.HandlerSpanName(typeName, operation), tracing.HandlerSpanAttributes(servicePackageName, typeName, operation)...)
+}
+
+// endHandlerSpan ends the tracing span for a CRUD handler invocation.
+func endHandlerSpan(span trace.Span, diags diag.Diagnostics) {
+	var summary string
+	if errs := diags.Errors(); len(errs) > 0 {
+		summary = errs[0].Summary()
+	}
+
+	tracing.EndSpanWithStatus(span, diags.HasError(), summary)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/importer"
@@ -99,7 +100,7 @@ func (w *wrappedDataSource) context(ctx context.Context, getAttribute getAttribu
 		overrideRegion = target.ValueString()
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion, "")
 	if c != nil {
 		ctx = c.RequestContext(ctx)
 	}
@@ -149,6 +150,9 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	ctx, span := startHandlerSpan(ctx, w.servicePackageName, w.spec.TypeName, "Read")
+	defer func() { endHandlerSpan(span, response.Diagnostics) }()
+
 	ctx, diags := w.context(ctx, request.Config.GetAttribute, &request.ProviderMeta, w.meta)
 	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
@@ -259,7 +263,7 @@ func (w *wrappedEphemeralResource) context(ctx context.Context, getAttribute get
 		overrideRegion = target.ValueString()
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion, "")
 	if c != nil {
 		ctx = c.EphemeralRequestContext(ctx)
 	}
@@ -294,6 +298,9 @@ func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral
 }
 
 func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	ctx, span := startHandlerSpan(ctx, w.servicePackageName, w.spec.TypeName, "Open")
+	defer func() { endHandlerSpan(span, response.Diagnostics) }()
+
 	ctx, diags := w.context(ctx, request.Config.GetAttribute, w.meta)
 	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
@@ -318,6 +325,9 @@ func (w *wrappedEphemeralResource) Configure(ctx context.Context, request epheme
 }
 
 func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	ctx, span := startHandlerSpan(ctx, w.servicePackageName, w.spec.TypeName, "Renew")
+	defer func() { endHandlerSpan(span, response.Diagnostics) }()
+
 	if v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew); ok {
 		ctx, diags := w.context(ctx, nil, w.meta)
 		response.Diagnostics.Append(diags...)
@@ -330,6 +340,9 @@ func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.
 }
 
 func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	ctx, span := startHandlerSpan(ctx, w.servicePackageName, w.spec.TypeName, "Close")
+	defer func() { endHandlerSpan(span, response.Diagnostics) }()
+
 	if v, ok := w.inner.(ephemeral.EphemeralResourceWithClose); ok {
 		ctx, diags := w.context(ctx, nil, w.meta)
 		response.Diagnostics.Append(diags...)
@@ -427,7 +440,7 @@ func (w *wrappedAction) context(ctx context.Context, getAttribute getAttributeFu
 		overrideRegion = target.ValueString()
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion, "")
 	if c != nil {
 		ctx = c.EphemeralRequestContext(ctx)
 	}
@@ -465,6 +478,9 @@ func (w *wrappedAction) Schema(ctx context.Context, request action.SchemaRequest
 }
 
 func (w *wrappedAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	ctx, span := startHandlerSpan(ctx, w.servicePackageName, w.spec.TypeName, "Invoke")
+	defer func() { endHandlerSpan(span, response.Diagnostics) }()
+
 	ctx, diags := w.context(ctx, request.Config.GetAttribute, w.meta)
 	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
@@ -536,8 +552,23 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		isRegionOverrideEnabled = true
 	}
 
+	var isAssumeRoleOverrideEnabled bool
+	if v := spec.AssumeRole; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+		isAssumeRoleOverrideEnabled = true
+	}
+
 	var interceptors interceptorInvocations
 
+	if isAssumeRoleOverrideEnabled {
+		interceptors = append(interceptors, resourceInjectAssumeRoleARNAttribute())
+		interceptors = append(interceptors, resourceValidateAssumeRoleARN())
+		// Must run before any Region import interceptor as the IAM role ARN is the import ID's last suffix.
+		interceptors = append(interceptors, resourceImportAssumeRoleARN())
+
+		// Record the IAM role override in the resource identity, so that import by identity targets the same AWS account.
+		spec.Identity = spec.Identity.WithAssumeRoleARN()
+	}
+
 	if isRegionOverrideEnabled {
 		v := spec.Region.Value()
 
@@ -560,6 +591,10 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		interceptors = append(interceptors, resourceValidateRequiredTags())
 	}
 
+	if fwflex.ExplainDiffEnabled() {
+		interceptors = append(interceptors, resourceExplainAutoFlexDiff())
+	}
+
 	inner, _ := spec.Factory(context.TODO())
 
 	if len(spec.Identity.Attributes) == 0 {
@@ -597,7 +632,7 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 // context is run on all wrapped methods before any interceptors.
 func (w *wrappedResource) context(ctx context.Context, getAttribute getAttributeFunc, providerMeta *tfsdk.Config, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var overrideRegion string
+	var overrideRegion, overrideAssumeRoleARN string
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
@@ -614,7 +649,17 @@ func (w *wrappedResource) context(ctx context.Context, getAttribute getAttribute
 		overrideRegion = target.ValueString()
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	if w.isAssumeRoleOverrideEnabled() && getAttribute != nil {
+		var target types.String
+		diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRoleARN), &target)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		overrideAssumeRoleARN = target.ValueString()
+	}
+
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion, overrideAssumeRoleARN)
 	if c != nil {
 		ctx = c.RequestContext(ctx)
 	}
@@ -634,6 +679,12 @@ func (w *wrappedResource) context(ctx context.Context, getAttribute getAttribute
 	return ctx, diags
 }
 
+// isAssumeRoleOverrideEnabled returns whether the resource supports per-resource IAM role override.
+func (w *wrappedResource) isAssumeRoleOverrideEnabled() bool {
+	v := w.spec.AssumeRole
+	return !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled
+}
+
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
 	// This method does not call down to the inner resource.
 	response.TypeName = w.spec.TypeName
@@ -665,6 +716,9 @@ func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaReq
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	ctx, span := startHandlerSpan(ctx, w.servicePackageName, w.spec.TypeName, "Create")
+	defer func() { endHandlerSpan(span, response.Diagnostics) }()
+
 	ctx, diags := w.context(ctx, request.Plan.GetAttribute, &request.ProviderMeta, w.meta)
 	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
@@ -675,16 +729,27 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	ctx, span := startHandlerSpan(ctx, w.servicePackageName, w.spec.TypeName, "Read")
+	defer func() { endHandlerSpan(span, response.Diagnostics) }()
+
 	ctx, diags := w.context(ctx, request.State.GetAttribute, &request.ProviderMeta, w.meta)
 	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	if fwflex.ExplainDiffEnabled() {
+		// Record the AutoFlex rules used to flatten the API response.
+		ctx = fwflex.NewFlattenTraceContext(ctx)
+	}
+
 	interceptedHandler(w.interceptors.resourceRead(), w.inner.Read, resourceReadHasError, w.meta)(ctx, request, response)
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	ctx, span := startHandlerSpan(ctx, w.servicePackageName, w.spec.TypeName, "Update")
+	defer func() { endHandlerSpan(span, response.Diagnostics) }()
+
 	ctx, diags := w.context(ctx, request.Plan.GetAttribute, &request.ProviderMeta, w.meta)
 	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
@@ -695,6 +760,9 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	ctx, span := startHandlerSpan(ctx, w.servicePackageName, w.spec.TypeName, "Delete")
+	defer func() { endHandlerSpan(span, response.Diagnostics) }()
+
 	ctx, diags := w.context(ctx, request.State.GetAttribute, &request.ProviderMeta, w.meta)
 	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
@@ -720,7 +788,12 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
-		ctx, diags := w.context(ctx, nil, nil, w.meta)
+		var getAttribute getAttributeFunc
+		if w.isAssumeRoleOverrideEnabled() {
+			// Any per-resource IAM role override is in the import ID or resource identity.
+			getAttribute = importGetAttribute(request)
+		}
+		ctx, diags := w.context(ctx, getAttribute, nil, w.meta)
 		response.Diagnostics.Append(diags...)
 		if response.Diagnostics.HasError() {
 			return
@@ -906,7 +979,7 @@ func (w *wrappedListResourceFramework) context(ctx context.Context, getAttribute
 		}
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion, "")
 	if c != nil {
 		ctx = c.RequestContext(ctx)
 	}
@@ -1049,7 +1122,7 @@ func (w *wrappedListResourceSDK) context(ctx context.Context, getAttribute getAt
 		}
 	}
 
-	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion, "")
 	if c != nil {
 		ctx = c.RequestContext(ctx)
 	}
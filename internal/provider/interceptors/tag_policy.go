@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+type tagPolicyAWSClient interface {
+	AccountID(context.Context) string
+	DefaultTagsConfig(context.Context) *tftags.DefaultConfig
+	Partition(context.Context) string
+	Region(context.Context) string
+	TagPolicyConfig(context.Context) *tftags.TagPolicyConfig
+}
+
+// DefaultTagsConfig returns the provider's default tags configuration for resources of type typeName,
+// including any required tags remediated by the tag policy configuration.
+// The remediated tags are also returned.
+func DefaultTagsConfig(ctx context.Context, c tagPolicyAWSClient, typeName string) (*tftags.DefaultConfig, tftags.KeyValueTags, error) {
+	return c.TagPolicyConfig(ctx).RemediatedDefaultConfig(c.DefaultTagsConfig(ctx), typeName, func() tftags.RemediationData {
+		return tftags.RemediationData{
+			AccountID: c.AccountID(ctx),
+			Partition: c.Partition(ctx),
+			Region:    c.Region(ctx),
+		}
+	})
+}
+
+// ReadDefaultTagsConfig returns the provider's default tags configuration for resources of type typeName
+// when reading a resource's tags.
+// Tags are not written on Read, so a remediation error is logged and the unremediated configuration returned.
+func ReadDefaultTagsConfig(ctx context.Context, c tagPolicyAWSClient, typeName string) *tftags.DefaultConfig {
+	defaultTagsConfig, _, err := DefaultTagsConfig(ctx, c, typeName)
+	if err != nil {
+		tflog.Warn(ctx, "Required Tags Remediation", map[string]any{
+			"error": err.Error(),
+		})
+
+		return c.DefaultTagsConfig(ctx)
+	}
+
+	return defaultTagsConfig
+}
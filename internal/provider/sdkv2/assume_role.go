@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func resourceValidateAssumeRoleARN() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				return c.ValidateInContextAssumeRoleARNInPartition(ctx)
+			}
+		}
+
+		return nil
+	})
+}
+
+// importAssumeRoleARN sets any IAM role override from the import ID or, if withIdentity is true, from the resource identity.
+func importAssumeRoleARN(withIdentity bool) importInterceptor {
+	return interceptorFunc1[*schema.ResourceData, error](func(ctx context.Context, opts importInterceptorOptions) error {
+		d := opts.d
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Import:
+				// Import ID optionally ends with "@<IAM role ARN>", after any "@<region>".
+				if matches := regexache.MustCompile(`^(.+)@(` + inttypes.CanonicalIAMRoleARNPatternNoAnchors + `)$`).FindStringSubmatch(d.Id()); len(matches) == 3 {
+					d.SetId(matches[1])
+					if err := d.Set(names.AttrAssumeRoleARN, matches[2]); err != nil {
+						return fmt.Errorf("setting %s: %w", names.AttrAssumeRoleARN, err)
+					}
+				} else if withIdentity && d.Id() == "" {
+					// Import by identity. The identity optionally records the IAM role.
+					identity, err := d.Identity()
+					if err != nil {
+						return err
+					}
+
+					if v, ok := identity.GetOk(names.AttrAssumeRoleARN); ok {
+						if err := d.Set(names.AttrAssumeRoleARN, v); err != nil {
+							return fmt.Errorf("setting %s: %w", names.AttrAssumeRoleARN, err)
+						}
+					}
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func resourceImportAssumeRoleARN(withIdentity bool) interceptorInvocation {
+	return interceptorInvocation{
+		when:        Before,
+		why:         Import,
+		interceptor: importAssumeRoleARN(withIdentity),
+	}
+}
@@ -121,7 +121,7 @@ func RegionalMultipleParameterized(ctx context.Context, rd *schema.ResourceData,
 
 		for _, attr := range identitySpec.Attributes {
 			switch attr.Name() {
-			case names.AttrAccountID, names.AttrRegion:
+			case names.AttrAccountID, names.AttrAssumeRoleARN, names.AttrRegion:
 				// Do nothing
 
 			default:
@@ -163,7 +163,7 @@ func GlobalMultipleParameterized(ctx context.Context, rd *schema.ResourceData, i
 
 		for _, attr := range identitySpec.Attributes {
 			switch attr.Name() {
-			case names.AttrAccountID:
+			case names.AttrAccountID, names.AttrAssumeRoleARN:
 				// Do nothing
 
 			default:
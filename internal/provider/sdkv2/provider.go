@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/ratelimit"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -181,6 +182,30 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The region where AWS operations will take place. Examples\n" +
 						"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 				},
+				"rate_limits": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Client-side rate limits to apply to the AWS API calls made to individual services.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_in_flight": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "The maximum number of concurrent in-flight requests to the service.",
+							},
+							"requests_per_second": {
+								Type:        schema.TypeFloat,
+								Optional:    true,
+								Description: "The maximum number of requests per second sent to the service, across all Regions.",
+							},
+							"service": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The service, named as in the `endpoints` configuration block, e.g. `route53`.",
+							},
+						},
+					},
+				},
 				"retry_mode": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -260,6 +285,30 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 						`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 						`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 				},
+				"tag_policy_file": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `The path of a local AWS Organizations tag policy document to enforce instead of the organization's effective tag policy. ` +
+						`In addition to required tag keys, the document's tag key capitalization and allowed tag values are enforced for the resource types in its "enforced_for" values. ` +
+						`Can also be configured with the ` + tftags.TagPolicyFileEnvVar + ` environment variable.`,
+				},
+				"tag_policy_remediation": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Configuration block with settings to add required tags missing from resources when tag policy compliance is enforced.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"tags": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+								Description: "Values for required tag keys, added to resources whose configuration and default tags lack the key. " +
+									"Values are Go templates that can reference `.AccountID`, `.Partition`, `.Region` and `.ResourceType`.",
+							},
+						},
+					},
+				},
 				"token": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -479,12 +528,36 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	if dg.HasError() {
 		return nil, diags
 	}
+	if tagCfg != nil {
+		tagCfg.PolicyFile = expandTagPolicyFile(d.Get("tag_policy_file").(string))
+	} else if _, ok := d.GetOk("tag_policy_file"); ok {
+		diags = append(diags, errs.NewAttributeWarningDiagnostic(cty.GetAttrPath("tag_policy_file"),
+			"Tag Policy File Has No Effect",
+			"A tag policy file is only used when tag policy compliance is enforced. Set tag_policy_compliance to enforce tag policy compliance.",
+		))
+	}
+	if v, ok := d.GetOk("tag_policy_remediation"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		dg := expandTagPolicyRemediation(cty.GetAttrPath("tag_policy_remediation"), v.([]any)[0].(map[string]any), tagCfg)
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+	}
 	config.TagPolicyConfig = tagCfg
 
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limits"); ok && len(v.([]any)) > 0 {
+		rateLimits, dg := expandRateLimits(cty.GetAttrPath("rate_limits"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.RateLimits = rateLimits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -600,7 +673,7 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 						}
 					}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, v.TypeName, overrideRegion)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, v.TypeName, overrideRegion, "")
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = c.RequestContext(ctx)
 					}
@@ -618,8 +691,9 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 
 					return ctx, nil
 				},
-				interceptors: interceptors,
-				typeName:     typeName,
+				interceptors:       interceptors,
+				servicePackageName: servicePackageName,
+				typeName:           typeName,
 			}
 			wrapDataSource(r, opts)
 			p.provider.DataSourcesMap[typeName] = r
@@ -640,8 +714,49 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				isRegionOverrideEnabled = true
 			}
 
+			// Per-resource IAM role override is enabled wherever per-resource Region override is, unless the resource opts out.
+			// Global resources opt in.
+			isAssumeRoleOverrideEnabled, isAssumeRoleOverrideOptedIn := isRegionOverrideEnabled, false
+			if v := resource.AssumeRole; !tfunique.IsHandleNil(v) {
+				isAssumeRoleOverrideEnabled = v.Value().IsOverrideEnabled
+				isAssumeRoleOverrideOptedIn = isAssumeRoleOverrideEnabled
+			}
+
 			var interceptors interceptorInvocations
 
+			if isAssumeRoleOverrideEnabled {
+				s := r.SchemaMap()
+
+				if _, ok := s[names.AttrAssumeRoleARN]; !ok {
+					// Inject a top-level "assume_role_arn" attribute.
+					assumeRoleARNSchema := sdkv2.AssumeRoleARNOptionalForceNew()
+
+					if f := r.SchemaFunc; f != nil {
+						r.SchemaFunc = func() map[string]*schema.Schema {
+							s := f()
+							s[names.AttrAssumeRoleARN] = assumeRoleARNSchema
+							return s
+						}
+					} else {
+						r.Schema[names.AttrAssumeRoleARN] = assumeRoleARNSchema
+					}
+				}
+
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: resourceValidateAssumeRoleARN(),
+				})
+				// Must run before any Region import interceptor as the IAM role ARN is the import ID's last suffix.
+				interceptors = append(interceptors, resourceImportAssumeRoleARN(isAssumeRoleOverrideOptedIn))
+
+				// Record the IAM role override in the resource identity of opted-in resources, so that import by identity targets the same AWS account.
+				// Other resources' identity schemas are unchanged.
+				if isAssumeRoleOverrideOptedIn {
+					resource.Identity = resource.Identity.WithAssumeRoleARN()
+				}
+			}
+
 			if isRegionOverrideEnabled {
 				v := resource.Region.Value()
 				s := r.SchemaMap()
@@ -754,7 +869,7 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, getProviderMeta getProviderMetaFunc, meta any) (context.Context, error) {
-					var overrideRegion string
+					var overrideRegion, overrideAssumeRoleARN string
 
 					if isRegionOverrideEnabled && getAttribute != nil {
 						if region, ok := getAttribute(names.AttrRegion); ok && region != nil {
@@ -762,7 +877,13 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 						}
 					}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, resource.TypeName, overrideRegion)
+					if isAssumeRoleOverrideEnabled && getAttribute != nil {
+						if roleARN, ok := getAttribute(names.AttrAssumeRoleARN); ok && roleARN != nil {
+							overrideAssumeRoleARN = roleARN.(string)
+						}
+					}
+
+					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, resource.TypeName, overrideRegion, overrideAssumeRoleARN)
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = c.RequestContext(ctx)
 					}
@@ -780,8 +901,9 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 
 					return ctx, nil
 				},
-				interceptors: interceptors,
-				typeName:     typeName,
+				interceptors:       interceptors,
+				servicePackageName: servicePackageName,
+				typeName:           typeName,
 			}
 			wrapResource(r, opts)
 			p.provider.ResourcesMap[typeName] = r
@@ -1097,6 +1219,58 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
+func expandRateLimits(path cty.Path, tfList []any) (map[string]ratelimit.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rateLimits := make(map[string]ratelimit.Config, len(tfList))
+	servicePackageNames := names.ProviderPackages()
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := path.IndexInt(i)
+
+		service := tfMap["service"].(string)
+		servicePackageName := service
+		if !slices.Contains(servicePackageNames, service) {
+			v, err := names.ProviderPackageForAlias(service)
+			if err != nil {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(elementPath.GetAttr("service"), "Unknown service %q", service))
+				continue
+			}
+			servicePackageName = v
+		}
+
+		if _, ok := rateLimits[servicePackageName]; ok {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(elementPath.GetAttr("service"), "Duplicate rate limits for service %q", service))
+			continue
+		}
+
+		var config ratelimit.Config
+		if v, ok := tfMap["max_in_flight"].(int); ok {
+			if v < 0 {
+				diags = append(diags, errs.NewInvalidValueAttributeError(elementPath.GetAttr("max_in_flight"), "Must be greater than or equal to 0"))
+				continue
+			}
+			config.MaxInFlight = v
+		}
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			if v < 0 {
+				diags = append(diags, errs.NewInvalidValueAttributeError(elementPath.GetAttr("requests_per_second"), "Must be greater than or equal to 0"))
+				continue
+			}
+			config.RequestsPerSecond = v
+		}
+
+		rateLimits[servicePackageName] = config
+	}
+
+	return rateLimits, diags
+}
+
 func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
@@ -1109,6 +1283,44 @@ func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyCon
 	return nil, nil
 }
 
+func expandTagPolicyFile(filename string) string {
+	if filename != "" {
+		return filename
+	}
+
+	return os.Getenv(tftags.TagPolicyFileEnvVar)
+}
+
+func expandTagPolicyRemediation(path cty.Path, tfMap map[string]any, tagPolicyConfig *tftags.TagPolicyConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tags := make(map[string]string)
+	if v, ok := tfMap["tags"].(map[string]any); ok {
+		for k, v := range v {
+			tags[k] = v.(string)
+		}
+	}
+
+	if len(tags) == 0 {
+		return diags
+	}
+
+	if tagPolicyConfig == nil {
+		return append(diags, errs.NewAttributeWarningDiagnostic(path,
+			"Tag Policy Remediation Has No Effect",
+			"Required tags are only remediated when tag policy compliance is enforced. Set tag_policy_compliance to enforce tag policy compliance.",
+		))
+	}
+
+	remediationTags, err := tftags.NewRemediationTags(tags)
+	if err != nil {
+		return append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("tags"), "%s", err))
+	}
+	tagPolicyConfig.RemediationTags = remediationTags
+
+	return diags
+}
+
 func validateTagPolicySeverity(path cty.Path, s string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	switch s {
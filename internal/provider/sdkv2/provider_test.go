@@ -18,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
@@ -438,6 +440,70 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandRateLimits(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfList        []any
+		expected      map[string]ratelimit.Config
+		expectedDiags diag.Diagnostics
+	}{
+		"service package name": {
+			tfList: []any{
+				map[string]any{"service": "route53", "requests_per_second": 5.0, "max_in_flight": 0},
+			},
+			expected: map[string]ratelimit.Config{
+				"route53": {RequestsPerSecond: 5},
+			},
+		},
+		"service alias": {
+			tfList: []any{
+				map[string]any{"service": "cloudwatchlogs", "requests_per_second": 0.0, "max_in_flight": 2},
+			},
+			expected: map[string]ratelimit.Config{
+				"logs": {MaxInFlight: 2},
+			},
+		},
+		"unknown service": {
+			tfList: []any{
+				map[string]any{"service": "unknown", "requests_per_second": 5.0, "max_in_flight": 0},
+			},
+			expected: map[string]ratelimit.Config{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(cty.GetAttrPath("rate_limits").IndexInt(0).GetAttr("service"), `Unknown service "unknown"`),
+			},
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{"service": "logs", "requests_per_second": 5.0, "max_in_flight": 0},
+				map[string]any{"service": "cloudwatchlogs", "requests_per_second": 1.0, "max_in_flight": 0},
+			},
+			expected: map[string]ratelimit.Config{
+				"logs": {RequestsPerSecond: 5},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(cty.GetAttrPath("rate_limits").IndexInt(1).GetAttr("service"), `Duplicate rate limits for service "cloudwatchlogs"`),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandRateLimits(cty.GetAttrPath("rate_limits"), testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("Unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testcase.expected, results); diff != "" {
+				t.Errorf("Unexpected rate_limits diff: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -5,8 +5,10 @@ package sdkv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -40,17 +42,30 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 		return diags
 	}
 
-	sp, serviceName, resourceName, _, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
+	sp, serviceName, resourceName, typeName, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
 	if !ok {
 		return diags
 	}
 
+	// Tag policy remediation errors are only reported when tags are written.
+	var defaultTagsConfig *tftags.DefaultConfig
+	switch opts.why {
+	case Create, Update:
+		v, _, err := interceptors.DefaultTagsConfig(ctx, c, typeName)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "remediating required tags for %s %s: %s", serviceName, resourceName, err)
+		}
+		defaultTagsConfig = v
+	case Read:
+		defaultTagsConfig = interceptors.ReadDefaultTagsConfig(ctx, c, typeName)
+	}
+
 	switch d, when, why := opts.d, opts.when, opts.why; when {
 	case Before:
 		switch why {
 		case Create, Update:
 			// Merge the resource's configured tags with any provider configured default_tags.
-			tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)))
+			tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)))
 			// Remove system tags.
 			tags = tags.IgnoreSystem(sp.ServicePackageName())
 
@@ -102,7 +117,7 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 			tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx))
 
 			// The resource's configured tags can now include duplicate tags that have been configured on the provider.
-			if err := d.Set(names.AttrTags, tags.ResolveDuplicates(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), d, names.AttrTags, nil).Map()); err != nil {
+			if err := d.Set(names.AttrTags, tags.ResolveDuplicates(ctx, defaultTagsConfig, c.IgnoreTagsConfig(ctx), d, names.AttrTags, nil).Map()); err != nil {
 				return sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTags, err)
 			}
 
@@ -172,7 +187,7 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 				oldTags := tftags.New(ctx, stateTags)
 				// if tags_all was computed because not wholly known
 				// Merge the resource's configured tags with any provider configured default_tags.
-				newTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, configTags))
+				newTags := defaultTagsConfig.MergeTags(tftags.New(ctx, configTags))
 				// Remove system tags.
 				newTags = newTags.IgnoreSystem(sp.ServicePackageName())
 
@@ -188,7 +203,7 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 				toAdd := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx))
 
 				// The resource's configured tags can now include duplicate tags that have been configured on the provider.
-				if err := d.Set(names.AttrTags, toAdd.ResolveDuplicates(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), d, names.AttrTags, nil).Map()); err != nil {
+				if err := d.Set(names.AttrTags, toAdd.ResolveDuplicates(ctx, defaultTagsConfig, c.IgnoreTagsConfig(ctx), d, names.AttrTags, nil).Map()); err != nil {
 					return sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTags, err)
 				}
 
@@ -283,8 +298,15 @@ func setTagsAll() customizeDiffInterceptor {
 					return nil
 				}
 
+				_, _, _, typeName, _, _ := interceptors.InfoFromContext(ctx, c) //nolint:dogsled // legitimate use as-is, signature to be refactored
+				// Any required tags remediated by the tag policy configuration are shown in the plan as part of `tags_all`.
+				defaultTagsConfig, _, err := interceptors.DefaultTagsConfig(ctx, c, typeName)
+				if err != nil {
+					return fmt.Errorf("remediating required tags: %w", err)
+				}
+
 				newTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
-				allTags := c.DefaultTagsConfig(ctx).MergeTags(newTags).IgnoreConfig(c.IgnoreTagsConfig(ctx))
+				allTags := defaultTagsConfig.MergeTags(newTags).IgnoreConfig(c.IgnoreTagsConfig(ctx))
 				if d.HasChange(names.AttrTags) {
 					if newTags.HasZeroValue() {
 						if err := d.SetNewComputed(names.AttrTagsAll); err != nil {
@@ -343,7 +365,7 @@ func validateRequiredTags() customizeDiffInterceptor {
 			return nil
 		}
 		reqTags, ok := policy.RequiredTags[typeName]
-		if !ok {
+		if !ok && len(policy.Rules[typeName]) == 0 {
 			return nil
 		}
 
@@ -362,29 +384,45 @@ func validateRequiredTags() customizeDiffInterceptor {
 					return nil
 				}
 
-				cfgTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
-				allTags := c.DefaultTagsConfig(ctx).MergeTags(cfgTags)
-				if allTags.ContainsAllKeys(reqTags) {
-					return nil
+				defaultTagsConfig, remediatedTags, err := interceptors.DefaultTagsConfig(ctx, c, typeName)
+				if err != nil {
+					return fmt.Errorf("remediating required tags: %w", err)
 				}
 
-				missing := reqTags.Removed(allTags).Keys()
-				slices.Sort(missing)
-				summary := "Missing Required Tags"
-				detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
-
-				// CustomizeDiff does not support diagnostics (only an error return)
-				switch policy.Severity {
-				case "warning":
-					// Warning diagnostics are only logged
-					tflog.Warn(ctx, "Required Tags Validation", map[string]any{
-						"summary": summary,
-						"detail":  detail,
+				cfgTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
+				if injected := remediatedTags.Removed(cfgTags); len(injected) > 0 {
+					// CustomizeDiff does not support warning diagnostics; the remediated tags are also shown in the planned `tags_all`.
+					tflog.Warn(ctx, "Required Tags Remediated", map[string]any{
+						"detail": fmt.Sprintf("The following tags were added to satisfy an organizational tag policy for %s: %v", typeName, injected.Map()),
 					})
-				default:
-					// Error diagnostics merge summary and detail into a single message
-					return fmt.Errorf("%s - %s", summary, detail)
 				}
+
+				var errs []error
+				report := func(summary, detail string) {
+					// CustomizeDiff does not support diagnostics (only an error return)
+					switch policy.Severity {
+					case "warning":
+						// Warning diagnostics are only logged
+						tflog.Warn(ctx, "Required Tags Validation", map[string]any{
+							"summary": summary,
+							"detail":  detail,
+						})
+					default:
+						// Error diagnostics merge summary and detail into a single message
+						errs = append(errs, fmt.Errorf("%s - %s", summary, detail))
+					}
+				}
+
+				allTags := defaultTagsConfig.MergeTags(cfgTags)
+				if missing := reqTags.Removed(allTags).Keys(); len(missing) > 0 {
+					slices.Sort(missing)
+					report("Missing Required Tags", fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing))
+				}
+				if violations := policy.Violations(typeName, allTags); len(violations) > 0 {
+					report("Noncompliant Tags", fmt.Sprintf("An organizational tag policy does not allow the following tags for %s: %s", typeName, strings.Join(violations, "; ")))
+				}
+
+				return errors.Join(errs...)
 			}
 		}
 
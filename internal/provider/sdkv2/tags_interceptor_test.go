@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"text/template"
 	"unique"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -86,7 +87,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}))
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "test", "aws_test", "")
+		ctx = conns.NewResourceContext(ctx, "Test", "test", "aws_test", "", "")
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx), v.TagPolicyConfig(ctx))
 		}
@@ -111,6 +112,79 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 }
 
+func TestTagsResourceInterceptor_remediationError(t *testing.T) {
+	t.Parallel()
+
+	remediation, err := template.New("owner").Funcs(template.FuncMap{
+		"fail": func() (string, error) { return "", errors.New("test error") },
+	}).Parse("{{ fail }}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		when       when
+		why        why
+		expectDiag bool
+	}{
+		"Before Create": {
+			when:       Before,
+			why:        Create,
+			expectDiag: true,
+		},
+		"Before Update": {
+			when:       Before,
+			why:        Update,
+			expectDiag: true,
+		},
+		"After Read": {
+			when: After,
+			why:  Read,
+		},
+		"Before Delete": {
+			when: Before,
+			why:  Delete,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			sp := unique.Make(inttypes.ServicePackageResourceTags{})
+			interceptor := resourceTransparentTagging(sp)
+
+			conn := &conns.AWSClient{}
+			conn.SetServicePackages(ctx, map[string]conns.ServicePackage{
+				"Test": &mockService{},
+			})
+			conns.SetTagPolicyConfig(conn, &tftags.TagPolicyConfig{
+				RequiredTags: map[string]tftags.KeyValueTags{
+					"aws_test": tftags.New(ctx, []string{"owner"}),
+				},
+				RemediationTags: map[string]*template.Template{
+					"owner": remediation,
+				},
+			})
+
+			ctx = conns.NewResourceContext(ctx, "Test", "test", "aws_test", "us-west-2", "") //lintignore:AWSAT003
+			ctx = tftags.NewContext(ctx, conn.DefaultTagsConfig(ctx), conn.IgnoreTagsConfig(ctx), conn.TagPolicyConfig(ctx))
+
+			opts := crudInterceptorOptions{
+				c:    conn,
+				d:    &resourceData{},
+				when: testCase.when,
+				why:  testCase.why,
+			}
+			diags := interceptor.run(ctx, opts)
+			if got, want := diags.HasError(), testCase.expectDiag; got != want {
+				t.Errorf("diags.HasError() = %v, want %v: %v", got, want, diags)
+			}
+		})
+	}
+}
+
 type resourceData struct{}
 
 func (d *resourceData) GetRawConfig() cty.Value {
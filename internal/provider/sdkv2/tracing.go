@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+)
+
+// tracedCRUDHandler wraps a CRUD handler, including its interceptors, in a tracing span.
+// AWS API operations and waiters invoked by the handler are recorded as child spans.
+func tracedCRUDHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](servicePackageName, typeName, operation string, f F) F {
+	if f == nil || !tracing.Enabled() {
+		return f
+	}
+
+	return func(ctx context.Context, rd *schema.ResourceData, meta any) diag.Diagnostics {
+		ctx, span := tracing.StartSpan(ctx, tracing.HandlerSpanName(typeName, operation), tracing.HandlerSpanAttributes(servicePackageName, typeName, operation)...)
+
+		diags := f(ctx, rd, meta)
+
+		var summary string
+		if diags.HasError() {
+			for _, d := range diags {
+				if d.Severity == diag.Error {
+					summary = d.Summary
+					break
+				}
+			}
+		}
+		tracing.EndSpanWithStatus(span, diags.HasError(), summary)
+
+		return diags
+	}
+}
@@ -20,9 +20,10 @@ type contextFunc func(context.Context, getAttributeFunc, getProviderMetaFunc, an
 
 type wrappedDataSourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
-	bootstrapContext contextFunc
-	interceptors     interceptorInvocations
-	typeName         string
+	bootstrapContext   contextFunc
+	interceptors       interceptorInvocations
+	servicePackageName string
+	typeName           string
 }
 
 // wrappedDataSource represents an interceptor dispatcher for a Plugin SDK v2 data source.
@@ -41,14 +42,15 @@ func wrapDataSource(r *schema.Resource, opts wrappedDataSourceOptions) {
 }
 
 func (w *wrappedDataSource) read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Read)
+	return tracedCRUDHandler(w.opts.servicePackageName, w.opts.typeName, "Read", interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Read))
 }
 
 type wrappedResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
-	bootstrapContext contextFunc
-	interceptors     interceptorInvocations
-	typeName         string
+	bootstrapContext   contextFunc
+	interceptors       interceptorInvocations
+	servicePackageName string
+	typeName           string
 }
 
 // wrappedResource represents an interceptor dispatcher for a Plugin SDK v2 resource.
@@ -75,19 +77,19 @@ func wrapResource(r *schema.Resource, opts wrappedResourceOptions) {
 }
 
 func (w *wrappedResource) create(f schema.CreateContextFunc) schema.CreateContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Create)
+	return tracedCRUDHandler(w.opts.servicePackageName, w.opts.typeName, "Create", interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Create))
 }
 
 func (w *wrappedResource) read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Read)
+	return tracedCRUDHandler(w.opts.servicePackageName, w.opts.typeName, "Read", interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Read))
 }
 
 func (w *wrappedResource) update(f schema.UpdateContextFunc) schema.UpdateContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Update)
+	return tracedCRUDHandler(w.opts.servicePackageName, w.opts.typeName, "Update", interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Update))
 }
 
 func (w *wrappedResource) delete(f schema.DeleteContextFunc) schema.DeleteContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Delete)
+	return tracedCRUDHandler(w.opts.servicePackageName, w.opts.typeName, "Delete", interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Delete))
 }
 
 func (w *wrappedResource) import_(f schema.StateContextFunc) schema.StateContextFunc {
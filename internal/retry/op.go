@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type opFunc[T any] func(context.Context) (T, error)
@@ -74,6 +76,15 @@ func (op opFunc[T]) UntilNotFound() runFunc[T] {
 	}
 }
 
+// attempt runs the operation once.
+// When tracing is enabled, the attempt is recorded as a span.
+func (op opFunc[T]) attempt(ctx context.Context, n int) (t T, err error) {
+	ctx, span := tracing.StartSpan(ctx, "Retry.Attempt", attribute.Int("tf_aws.retry.attempt", n))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	return op(ctx)
+}
+
 func (op opFunc[T]) If(predicate predicateFunc[T]) runFunc[T] {
 	// The default predicate short-circuits a retry loop if the operation returns any error.
 	if predicate == nil {
@@ -87,11 +98,13 @@ func (op opFunc[T]) If(predicate predicateFunc[T]) runFunc[T] {
 		// with the Plugin SDKv2 implementation. A parent context may have set a deadline.
 		var (
 			l   *backoff.Loop
+			n   int
 			t   T
 			err error
 		)
 		for l = backoff.NewLoopWithOptions(timeout, opts...); l.Continue(ctx); {
-			t, err = op(ctx)
+			n++
+			t, err = op.attempt(ctx, n)
 
 			var retry bool
 			if retry, err = predicate(t, err); !retry {
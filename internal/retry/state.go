@@ -12,8 +12,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"go.opentelemetry.io/otel/attribute"
 	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
 )
 
@@ -70,7 +72,16 @@ type StateChangeConf = StateChangeConfOf[any, string]
 //
 // When VCR testing is enabled in replay mode, the DelayFunc is overridden to
 // allow interactions to be replayed with no delay between state change refreshes.
-func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T, error) {
+//
+// When tracing is enabled, the wait and each refresh are recorded as spans.
+func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (t T, err error) {
+	ctx, span := tracing.StartSpan(ctx, "WaitForState",
+		attribute.StringSlice("tf_aws.wait.pending", tfslices.Strings(conf.Pending)),
+		attribute.StringSlice("tf_aws.wait.target", tfslices.Strings(conf.Target)),
+		attribute.String("tf_aws.wait.timeout", conf.Timeout.String()),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	// Set a default for times to check for not found.
 	if conf.NotFoundChecks == 0 {
 		conf.NotFoundChecks = 20
@@ -90,9 +101,7 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 	}
 
 	var (
-		t                             T
 		currentState, priorState      S
-		err                           error
 		notFoundTick, targetOccurence int
 		l                             *backoff.Loop
 	)
@@ -184,7 +193,13 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 	return t, context.Cause(ctx)
 }
 
-func (conf *StateChangeConfOf[T, S]) refreshWithTimeout(ctx context.Context, timeout time.Duration) (T, S, error) {
+func (conf *StateChangeConfOf[T, S]) refreshWithTimeout(ctx context.Context, timeout time.Duration) (t T, state S, err error) {
+	ctx, span := tracing.StartSpan(ctx, "WaitForState.Refresh")
+	defer func() {
+		span.SetAttributes(attribute.String("tf_aws.wait.state", string(state)))
+		tracing.EndSpan(span, err)
+	}()
+
 	// Set a deadline on the context here to maintain compatibility with the Plugin SDKv2 implementation.
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
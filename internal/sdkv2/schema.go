@@ -6,8 +6,10 @@ package sdkv2
 import (
 	"sync"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -164,6 +166,17 @@ func jsonDocumentSchemaRequiredForceNewFunc(diffSuppressFunc schema.SchemaDiffSu
 	}
 }
 
+// AssumeRoleARNOptionalForceNew returns the standard schema for an optional IAM role ARN used for per-resource credentials.
+var AssumeRoleARNOptionalForceNew = sync.OnceValue(func() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringMatch(regexache.MustCompile(inttypes.CanonicalIAMRoleARNPattern), "must be an IAM role ARN"),
+		Description:  names.ResourceTopLevelAssumeRoleARNAttributeDescription,
+	}
+})
+
 // RegionOptionalComputed returns the standard schema for an optional, computed AWS Region.
 var RegionOptionalComputed = sync.OnceValue(func() *schema.Schema {
 	return &schema.Schema{
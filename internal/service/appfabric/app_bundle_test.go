@@ -648,7 +648,7 @@ func testAccCheckAppBundleExists(ctx context.Context, t *testing.T, n string, v
 
 func testAccCheckAppBundleExistsInRegion(ctx context.Context, t *testing.T, n string, v *awstypes.AppBundle, region string) resource.TestCheckFunc {
 	// Push region into Context.
-	ctx = conns.NewResourceContext(ctx, "AppFabric", "App Bundle", "aws_appfabric_app_bundle", region)
+	ctx = conns.NewResourceContext(ctx, "AppFabric", "App Bundle", "aws_appfabric_app_bundle", region, "")
 	return testAccCheckAppBundleExists(ctx, t, n, v)
 }
 
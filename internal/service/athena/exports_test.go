@@ -11,6 +11,7 @@ var (
 	FindNamedQueryByID                = findNamedQueryByID
 	FindPreparedStatementByTwoPartKey = findPreparedStatementByTwoPartKey
 	FindWorkGroupByName               = findWorkGroupByName
+	QueryExecutionFailureMessage      = queryExecutionFailureMessage
 	QueryExecutionResult              = queryExecutionResult
 
 	ResourceCapacityReservation = newCapacityReservationResource
@@ -0,0 +1,258 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startQueryExecutionPollInterval defines polling cadence for the start query execution action.
+const startQueryExecutionPollInterval = 5 * time.Second
+
+// @Action(aws_athena_start_query_execution, name="Start Query Execution")
+func newStartQueryExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startQueryExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startQueryExecutionAction)(nil)
+)
+
+type startQueryExecutionAction struct {
+	framework.ActionWithModel[startQueryExecutionModel]
+}
+
+type startQueryExecutionModel struct {
+	framework.WithRegionModel
+	Catalog             types.String         `tfsdk:"catalog"`
+	Database            types.String         `tfsdk:"database"`
+	ExecutionParameters fwtypes.ListOfString `tfsdk:"execution_parameters"`
+	OutputLocation      types.String         `tfsdk:"output_location"`
+	QueryString         types.String         `tfsdk:"query_string"`
+	Timeout             types.Int64          `tfsdk:"timeout"`
+	WorkGroup           types.String         `tfsdk:"workgroup"`
+}
+
+func (a *startQueryExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an Athena query and waits for the query execution to finish.",
+		Attributes: map[string]schema.Attribute{
+			"catalog": schema.StringAttribute{
+				Description: "The name of the data catalog used in the query execution",
+				Optional:    true,
+			},
+			names.AttrDatabase: schema.StringAttribute{
+				Description: "The name of the database used in the query execution",
+				Optional:    true,
+			},
+			"execution_parameters": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "Values for the parameters in the query, applied in the order in which the parameters occur",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"output_location": schema.StringAttribute{
+				Description: "The Amazon S3 location where query results are stored, e.g. s3://bucket/path/. Required unless the workgroup specifies an output location",
+				Optional:    true,
+			},
+			"query_string": schema.StringAttribute{
+				Description: "The SQL query statement to run",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 262144),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the query execution to finish (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(86400),
+				},
+			},
+			"workgroup": schema.StringAttribute{
+				Description: "The name of the workgroup in which the query is run. Defaults to the primary workgroup",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *startQueryExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startQueryExecutionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AthenaClient(ctx)
+
+	timeout := fwactions.TimeoutOr(config.Timeout, 1800*time.Second)
+
+	tflog.Info(ctx, "Starting Athena start query execution action", map[string]any{
+		"query_length":    len(config.QueryString.ValueString()),
+		"workgroup":       config.WorkGroup.ValueString(),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting Athena query execution...")
+
+	input := athena.StartQueryExecutionInput{
+		ExecutionParameters: fwflex.ExpandFrameworkStringValueList(ctx, config.ExecutionParameters),
+		QueryString:         fwflex.StringFromFramework(ctx, config.QueryString),
+		WorkGroup:           fwflex.StringFromFramework(ctx, config.WorkGroup),
+	}
+
+	if !config.Catalog.IsNull() || !config.Database.IsNull() {
+		input.QueryExecutionContext = &awstypes.QueryExecutionContext{
+			Catalog:  fwflex.StringFromFramework(ctx, config.Catalog),
+			Database: fwflex.StringFromFramework(ctx, config.Database),
+		}
+	}
+
+	if !config.OutputLocation.IsNull() {
+		input.ResultConfiguration = &awstypes.ResultConfiguration{
+			OutputLocation: fwflex.StringFromFramework(ctx, config.OutputLocation),
+		}
+	}
+
+	output, err := conn.StartQueryExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Query Execution",
+			fmt.Sprintf("Could not start Athena query execution: %s", err),
+		)
+		return
+	}
+
+	queryExecutionID := aws.ToString(output.QueryExecutionId)
+
+	cb(ctx, "Athena query execution %s started, waiting for it to finish...", queryExecutionID)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.QueryExecution], error) {
+		output, err := findQueryExecutionByID(ctx, conn, queryExecutionID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.QueryExecution]{}, fmt.Errorf("describing query execution: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.QueryExecution]{Status: actionwait.Status(output.Status.State), Value: output}, nil
+	}, actionwait.Options[*awstypes.QueryExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startQueryExecutionPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.QueryExecutionStateSucceeded)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateQueued),
+			actionwait.Status(awstypes.QueryExecutionStateRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateCancelled),
+			actionwait.Status(awstypes.QueryExecutionStateFailed),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Athena query execution %s is %s", queryExecutionID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Query Execution",
+				fmt.Sprintf("Athena query execution %s did not finish within %s: %s", queryExecutionID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			reason := string(failureErr.Status)
+			if message := queryExecutionFailureMessage(result.Value); message != "" {
+				reason = fmt.Sprintf("%s: %s", reason, message)
+			}
+			resp.Diagnostics.AddError(
+				"Query Execution Failed",
+				fmt.Sprintf("Athena query execution %s did not succeed: %s", queryExecutionID, reason),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Query Execution State",
+				fmt.Sprintf("Athena query execution %s entered unexpected state: %s", queryExecutionID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Query Execution",
+				fmt.Sprintf("Error while waiting for Athena query execution %s: %s", queryExecutionID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Athena query execution %s completed successfully", queryExecutionID)
+
+	tflog.Info(ctx, "Athena start query execution action completed successfully", map[string]any{
+		"query_execution_id": queryExecutionID,
+	})
+}
+
+// queryExecutionFailureMessage returns the service-reported reason a query execution failed.
+func queryExecutionFailureMessage(queryExecution *awstypes.QueryExecution) string {
+	if queryExecution == nil || queryExecution.Status == nil {
+		return ""
+	}
+
+	if v := queryExecution.Status.AthenaError; v != nil && v.ErrorMessage != nil {
+		return aws.ToString(v.ErrorMessage)
+	}
+
+	return aws.ToString(queryExecution.Status.StateChangeReason)
+}
+
+func findQueryExecutionByID(ctx context.Context, conn *athena.Client, id string) (*awstypes.QueryExecution, error) {
+	input := athena.GetQueryExecutionInput{
+		QueryExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryExecution(ctx, &input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "was not found") {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.QueryExecution == nil || output.QueryExecution.Status == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.QueryExecution, nil
+}
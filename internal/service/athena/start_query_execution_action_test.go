@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestQueryExecutionFailureMessage(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		queryExecution *awstypes.QueryExecution
+		expected       string
+	}{
+		"nil": {},
+		"no status": {
+			queryExecution: &awstypes.QueryExecution{},
+		},
+		"state change reason": {
+			queryExecution: &awstypes.QueryExecution{
+				Status: &awstypes.QueryExecutionStatus{
+					StateChangeReason: aws.String("Query cancelled by user"),
+				},
+			},
+			expected: "Query cancelled by user",
+		},
+		"athena error": {
+			queryExecution: &awstypes.QueryExecution{
+				Status: &awstypes.QueryExecutionStatus{
+					AthenaError: &awstypes.AthenaError{
+						ErrorMessage: aws.String("TABLE_NOT_FOUND: Table does not exist"),
+					},
+					StateChangeReason: aws.String("TABLE_NOT_FOUND: line 1:15: Table does not exist"),
+				},
+			},
+			expected: "TABLE_NOT_FOUND: Table does not exist",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfathena.QueryExecutionFailureMessage(testCase.queryExecution), testCase.expected; got != want {
+				t.Errorf("QueryExecutionFailureMessage() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccAthenaStartQueryExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartQueryExecutionActionConfig_basic(rName, "SELECT 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartQueryExecutionActionSucceeded(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaStartQueryExecutionAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartQueryExecutionActionConfig_basic(rName, "SELECT * FROM tf_acc_test_nonexistent_table"),
+				ExpectError: regexache.MustCompile(`(?s)Query Execution Failed.*FAILED:`),
+			},
+		},
+	})
+}
+
+func testAccCheckStartQueryExecutionActionSucceeded(ctx context.Context, t *testing.T, workGroup string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).AthenaClient(ctx)
+
+		listInput := athena.ListQueryExecutionsInput{
+			WorkGroup: aws.String(workGroup),
+		}
+		listOutput, err := conn.ListQueryExecutions(ctx, &listInput)
+		if err != nil {
+			return fmt.Errorf("listing query executions in Athena workgroup %s: %w", workGroup, err)
+		}
+
+		if len(listOutput.QueryExecutionIds) != 1 {
+			return fmt.Errorf("expected 1 query execution in Athena workgroup %s, got %d", workGroup, len(listOutput.QueryExecutionIds))
+		}
+
+		getInput := athena.GetQueryExecutionInput{
+			QueryExecutionId: aws.String(listOutput.QueryExecutionIds[0]),
+		}
+		getOutput, err := conn.GetQueryExecution(ctx, &getInput)
+		if err != nil {
+			return fmt.Errorf("reading Athena query execution %s: %w", listOutput.QueryExecutionIds[0], err)
+		}
+
+		// The action waits for the query to finish, so it must already have succeeded.
+		if state := getOutput.QueryExecution.Status.State; state != awstypes.QueryExecutionStateSucceeded {
+			return fmt.Errorf("Athena query execution state is %s, expected %s", state, awstypes.QueryExecutionStateSucceeded)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartQueryExecutionActionConfig_basic(rName, query string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_workgroup" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+action "aws_athena_start_query_execution" "test" {
+  config {
+    query_string    = %[2]q
+    output_location = "s3://${aws_s3_bucket.test.bucket}/results/"
+    workgroup       = aws_athena_workgroup.test.name
+    timeout         = 300
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_athena_start_query_execution.test]
+    }
+  }
+
+  depends_on = [aws_athena_workgroup.test]
+}
+`, rName, query)
+}
@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startInstanceRefreshPollInterval defines polling cadence for the start instance refresh action.
+const startInstanceRefreshPollInterval = 30 * time.Second
+
+// @Action(aws_autoscaling_start_instance_refresh, name="Start Instance Refresh")
+func newStartInstanceRefreshAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startInstanceRefreshAction{}, nil
+}
+
+var (
+	_ action.Action = (*startInstanceRefreshAction)(nil)
+)
+
+type startInstanceRefreshAction struct {
+	framework.ActionWithModel[startInstanceRefreshModel]
+}
+
+type startInstanceRefreshModel struct {
+	framework.WithRegionModel
+	AutoRollback         types.Bool                                   `tfsdk:"auto_rollback"`
+	AutoScalingGroupName types.String                                 `tfsdk:"autoscaling_group_name"`
+	InstanceWarmup       types.Int64                                  `tfsdk:"instance_warmup"`
+	MaxHealthyPercentage types.Int64                                  `tfsdk:"max_healthy_percentage"`
+	MinHealthyPercentage types.Int64                                  `tfsdk:"min_healthy_percentage"`
+	SkipMatching         types.Bool                                   `tfsdk:"skip_matching"`
+	Strategy             fwtypes.StringEnum[awstypes.RefreshStrategy] `tfsdk:"strategy"`
+	Timeout              types.Int64                                  `tfsdk:"timeout"`
+}
+
+func (a *startInstanceRefreshAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an instance refresh of an Auto Scaling group and waits for the refresh to finish.",
+		Attributes: map[string]schema.Attribute{
+			"auto_rollback": schema.BoolAttribute{
+				Description: "Whether to roll back the Auto Scaling group to its previous configuration if the instance refresh fails",
+				Optional:    true,
+			},
+			"autoscaling_group_name": schema.StringAttribute{
+				Description: "The name of the Auto Scaling group",
+				Required:    true,
+			},
+			"instance_warmup": schema.Int64Attribute{
+				Description: "Number of seconds until a newly launched instance is configured and ready to use",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_healthy_percentage": schema.Int64Attribute{
+				Description: "Percentage of the desired capacity of the Auto Scaling group that can be in service and healthy, or pending, during the instance refresh",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(100, 200),
+				},
+			},
+			"min_healthy_percentage": schema.Int64Attribute{
+				Description: "Percentage of the desired capacity of the Auto Scaling group that must remain healthy during the instance refresh",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"skip_matching": schema.BoolAttribute{
+				Description: "Whether to skip replacing instances that already have the desired configuration",
+				Optional:    true,
+			},
+			"strategy": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.RefreshStrategy](),
+				Description: "The strategy to use for the instance refresh. Valid values: Rolling, ReplaceRootVolume. Defaults to Rolling",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the instance refresh to finish (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *startInstanceRefreshAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startInstanceRefreshModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AutoScalingClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, config.AutoScalingGroupName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 3600*time.Second)
+
+	tflog.Info(ctx, "Starting Auto Scaling start instance refresh action", map[string]any{
+		"autoscaling_group_name": name,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting instance refresh of Auto Scaling group %s...", name)
+
+	input := autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(name),
+		Preferences: &awstypes.RefreshPreferences{
+			AutoRollback:         fwflex.BoolFromFramework(ctx, config.AutoRollback),
+			InstanceWarmup:       fwflex.Int32FromFrameworkInt64(ctx, config.InstanceWarmup),
+			MaxHealthyPercentage: fwflex.Int32FromFrameworkInt64(ctx, config.MaxHealthyPercentage),
+			MinHealthyPercentage: fwflex.Int32FromFrameworkInt64(ctx, config.MinHealthyPercentage),
+			SkipMatching:         fwflex.BoolFromFramework(ctx, config.SkipMatching),
+		},
+		Strategy: config.Strategy.ValueEnum(),
+	}
+
+	output, err := conn.StartInstanceRefresh(ctx, &input)
+	if errs.IsA[*awstypes.InstanceRefreshInProgressFault](err) {
+		resp.Diagnostics.AddError(
+			"Instance Refresh Already In Progress",
+			fmt.Sprintf("Auto Scaling group %s already has an instance refresh in progress: %s", name, err),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Instance Refresh",
+			fmt.Sprintf("Could not start instance refresh of Auto Scaling group %s: %s", name, err),
+		)
+		return
+	}
+
+	id := aws.ToString(output.InstanceRefreshId)
+
+	cb(ctx, "Instance refresh %s started, waiting for it to finish...", id)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.InstanceRefresh], error) {
+		input := autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: aws.String(name),
+			InstanceRefreshIds:   []string{id},
+		}
+		output, err := findInstanceRefresh(ctx, conn, &input)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.InstanceRefresh]{}, fmt.Errorf("describing instance refresh: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.InstanceRefresh]{Status: actionwait.Status(output.Status), Value: output}, nil
+	}, actionwait.Options[*awstypes.InstanceRefresh]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startInstanceRefreshPollInterval),
+		ProgressInterval: time.Minute,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.InstanceRefreshStatusSuccessful)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusBaking),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelling),
+			actionwait.Status(awstypes.InstanceRefreshStatusInProgress),
+			actionwait.Status(awstypes.InstanceRefreshStatusPending),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelled),
+			actionwait.Status(awstypes.InstanceRefreshStatusFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackSuccessful),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if refresh, ok := fr.Value.(*awstypes.InstanceRefresh); ok {
+				cb(ctx, "Instance refresh %s is %s (%d%% complete, %d instances to update)", id, fr.Status, aws.ToInt32(refresh.PercentageComplete), aws.ToInt32(refresh.InstancesToUpdate))
+			}
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Instance Refresh",
+				fmt.Sprintf("Auto Scaling group %s instance refresh %s did not finish within %s: %s", name, id, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			reason := string(failureErr.Status)
+			if result.Value != nil && result.Value.StatusReason != nil {
+				reason = fmt.Sprintf("%s: %s", reason, aws.ToString(result.Value.StatusReason))
+			}
+			resp.Diagnostics.AddError(
+				"Instance Refresh Failed",
+				fmt.Sprintf("Auto Scaling group %s instance refresh %s did not succeed: %s", name, id, reason),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Instance Refresh State",
+				fmt.Sprintf("Auto Scaling group %s instance refresh %s entered unexpected state: %s", name, id, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Instance Refresh",
+				fmt.Sprintf("Error while waiting for Auto Scaling group %s instance refresh %s: %s", name, id, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Instance refresh %s of Auto Scaling group %s completed successfully", id, name)
+
+	tflog.Info(ctx, "Auto Scaling start instance refresh action completed successfully", map[string]any{
+		"autoscaling_group_name": name,
+		"instance_refresh_id":    id,
+	})
+}
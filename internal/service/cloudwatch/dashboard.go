@@ -25,6 +25,7 @@ import (
 
 // @SDKResource("aws_cloudwatch_dashboard", name="Dashboard")
 // @IdentityAttribute("dashboard_name")
+// @ListOperation("ListDashboards", items="DashboardEntries", id="DashboardName")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/cloudwatch;cloudwatch.GetDashboardOutput")
 // @Testing(idAttrDuplicates="dashboard_name")
 // @Testing(preIdentityVersion="v6.52.0")
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "internal/generate/listpages/main.go -ListOps=ListDashboards"; DO NOT EDIT.
+
+package cloudwatch
+
+import (
+	"context"
+	"iter"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func listDashboardsPages(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.ListDashboardsInput, fn func(*cloudwatch.ListDashboardsOutput, bool) bool, optFns ...func(*cloudwatch.Options)) error {
+	for {
+		output, err := conn.ListDashboards(ctx, input, optFns...)
+		if err != nil {
+			return smarterr.NewError(err)
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+// @SDKListResource("aws_cloudwatch_dashboard")
+func newDashboardResourceAsListResource() inttypes.ListResourceForSDK {
+	return framework.NewListResourceFromSDKv2Resource(resourceDashboard(), func(ctx context.Context, awsClient *conns.AWSClient) iter.Seq2[string, error] {
+		return func(yield func(string, error) bool) {
+			conn := awsClient.CloudWatchClient(ctx)
+			var input cloudwatch.ListDashboardsInput
+			err := listDashboardsPages(ctx, conn, &input, func(page *cloudwatch.ListDashboardsOutput, lastPage bool) bool {
+				for _, v := range page.DashboardEntries {
+					if !yield(aws.ToString(v.DashboardName), nil) {
+						return false
+					}
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				yield("", err)
+			}
+		}
+	})
+}
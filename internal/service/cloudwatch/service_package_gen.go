@@ -166,6 +166,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 
 func (p *servicePackage) SDKListResources(ctx context.Context) iter.Seq[*inttypes.ServicePackageSDKListResource] {
 	return slices.Values([]*inttypes.ServicePackageSDKListResource{
+		{
+			Factory:  newDashboardResourceAsListResource,
+			TypeName: "aws_cloudwatch_dashboard",
+			Name:     "Dashboard",
+			Region:   inttypes.ResourceRegionDefault(),
+			Identity: inttypes.RegionalSingleParameterIdentity(inttypes.StringIdentityAttribute("dashboard_name", true)),
+		},
 		{
 			Factory:  newMetricAlarmResourceAsListResource,
 			TypeName: "aws_cloudwatch_metric_alarm",
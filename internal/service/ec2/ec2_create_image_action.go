@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// createImagePollInterval defines polling cadence for the create image action.
+const createImagePollInterval = 15 * time.Second
+
+// @Action(aws_ec2_create_image, name="Create Image")
+func newCreateImageAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createImageAction{}, nil
+}
+
+var (
+	_ action.Action = (*createImageAction)(nil)
+)
+
+type createImageAction struct {
+	framework.ActionWithModel[createImageModel]
+}
+
+type createImageModel struct {
+	framework.WithRegionModel
+	Description types.String `tfsdk:"description"`
+	InstanceID  types.String `tfsdk:"instance_id"`
+	Name        types.String `tfsdk:"name"`
+	NoReboot    types.Bool   `tfsdk:"no_reboot"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *createImageAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an AMI from an EC2 instance and waits for the AMI to become available.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Description: "A description for the new AMI",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			names.AttrInstanceID: schema.StringAttribute{
+				Description: "The ID of the EC2 instance to create the AMI from",
+				Required:    true,
+				Validators: []validator.String{
+					instanceIDActionValidator(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Description: "A name for the new AMI",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 128),
+				},
+			},
+			"no_reboot": schema.BoolAttribute{
+				Description: "Whether to create the AMI without shutting down and rebooting the instance. File system integrity on the created image can't be guaranteed if set",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the AMI to become available (default: 2400)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(14400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createImageAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createImageModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EC2Client(ctx)
+
+	instanceID := fwflex.StringValueFromFramework(ctx, config.InstanceID)
+	name := fwflex.StringValueFromFramework(ctx, config.Name)
+	timeout := fwactions.TimeoutOr(config.Timeout, 2400*time.Second)
+
+	tflog.Info(ctx, "Starting EC2 create image action", map[string]any{
+		names.AttrInstanceID: instanceID,
+		names.AttrName:       name,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Creating AMI %s from EC2 instance %s...", name, instanceID)
+
+	input := ec2.CreateImageInput{
+		Description: fwflex.StringFromFramework(ctx, config.Description),
+		InstanceId:  aws.String(instanceID),
+		Name:        aws.String(name),
+		NoReboot:    fwflex.BoolFromFramework(ctx, config.NoReboot),
+	}
+
+	output, err := conn.CreateImage(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create Image",
+			fmt.Sprintf("Could not create AMI from EC2 instance %s: %s", instanceID, err),
+		)
+		return
+	}
+
+	imageID := aws.ToString(output.ImageId)
+
+	cb(ctx, "Created AMI %s from EC2 instance %s, waiting for it to become available...", imageID, instanceID)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Image], error) {
+		image, err := findImageByID(ctx, conn, imageID)
+		// The new AMI may not be visible immediately.
+		if retry.NotFound(err) {
+			return actionwait.FetchResult[*awstypes.Image]{Status: actionwait.Status(awstypes.ImageStatePending)}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Image]{}, fmt.Errorf("describing image: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.Image]{Status: actionwait.Status(image.State), Value: image}, nil
+	}, actionwait.Options[*awstypes.Image]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(createImagePollInterval),
+		ProgressInterval:   time.Minute,
+		SuccessStates:      []actionwait.Status{actionwait.Status(awstypes.ImageStateAvailable)},
+		TransitionalStates: []actionwait.Status{actionwait.Status(awstypes.ImageStatePending)},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.ImageStateError),
+			actionwait.Status(awstypes.ImageStateFailed),
+			actionwait.Status(awstypes.ImageStateInvalid),
+		},
+		ProgressSink: actionStateProgressSink(ctx, cb, "AMI", imageID, awstypes.ImageStateAvailable),
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Image",
+				fmt.Sprintf("AMI %s did not become available within %s: %s", imageID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			reason := string(failureErr.Status)
+			if result.Value != nil && result.Value.StateReason != nil {
+				reason = fmt.Sprintf("%s: %s", reason, aws.ToString(result.Value.StateReason.Message))
+			}
+			resp.Diagnostics.AddError(
+				"Image Creation Failed",
+				fmt.Sprintf("AMI %s created from EC2 instance %s did not become available: %s", imageID, instanceID, reason),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Image State",
+				fmt.Sprintf("AMI %s entered unexpected state: %s", imageID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Image",
+				fmt.Sprintf("Error while waiting for AMI %s to become available: %s", imageID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "AMI %s is available", imageID)
+
+	tflog.Info(ctx, "EC2 create image action completed successfully", map[string]any{
+		names.AttrInstanceID: instanceID,
+		"image_id":           imageID,
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2CreateImageAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EC2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateImageActionConfig_trigger(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateImageActionImageAvailable(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckCreateImageActionImageAvailable checks that the AMI created by the action is available
+// and registers its removal, as the AMI is not managed by Terraform.
+func testAccCheckCreateImageActionImageAvailable(ctx context.Context, t *testing.T, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).EC2Client(ctx)
+
+		input := ec2.DescribeImagesInput{
+			Filters: []awstypes.Filter{
+				{
+					Name:   aws.String(names.AttrName),
+					Values: []string{name},
+				},
+			},
+			Owners: []string{"self"},
+		}
+		output, err := conn.DescribeImages(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		if n := len(output.Images); n != 1 {
+			return fmt.Errorf("found %d AMIs named %s, want 1", n, name)
+		}
+
+		image := output.Images[0]
+
+		t.Cleanup(func() {
+			input := ec2.DeregisterImageInput{
+				DeleteAssociatedSnapshots: aws.Bool(true),
+				ImageId:                   image.ImageId,
+			}
+			if _, err := conn.DeregisterImage(context.Background(), &input); err != nil {
+				t.Errorf("deregistering AMI (%s): %s", aws.ToString(image.ImageId), err)
+			}
+		})
+
+		if got, want := image.State, awstypes.ImageStateAvailable; got != want {
+			return fmt.Errorf("AMI (%s) state = %s, want %s", aws.ToString(image.ImageId), got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCreateImageActionConfig_trigger(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		acctest.AvailableEC2InstanceTypeForAvailabilityZone("data.aws_availability_zones.available.names[0]", "t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+action "aws_ec2_create_image" "test" {
+  config {
+    instance_id = aws_instance.test.id
+    name        = %[1]q
+    description = "Terraform acceptance test"
+    no_reboot   = true
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ec2_create_image.test]
+    }
+  }
+}
+`, rName))
+}
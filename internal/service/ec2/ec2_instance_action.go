@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+)
+
+// Helpers shared by the EC2 instance actions.
+
+// instanceActionPollInterval defines polling cadence for the EC2 instance actions.
+// EC2 instance state transitions are predictable and relatively quick, so
+// consistent polling every 10s is optimal for these operations.
+const instanceActionPollInterval = 10 * time.Second
+
+// instanceActionProgressInterval defines how often progress updates are sent while waiting.
+const instanceActionProgressInterval = 30 * time.Second
+
+// instanceIDActionValidator validates the instance_id argument of the EC2 instance actions.
+func instanceIDActionValidator() validator.String {
+	return stringvalidator.RegexMatches(
+		regexache.MustCompile(`^i-[0-9a-f]{8,17}$`),
+		"must be a valid EC2 instance ID (e.g., i-1234567890abcdef0)",
+	)
+}
+
+// findInstanceForAction returns the EC2 instance an action operates on, adding an
+// error diagnostic if the instance cannot be described.
+func findInstanceForAction(ctx context.Context, conn *ec2.Client, instanceID string, diags *diag.Diagnostics) *awstypes.Instance {
+	instance, err := findInstanceByID(ctx, conn, instanceID)
+	if retry.NotFound(err) {
+		diags.AddError(
+			"Instance Not Found",
+			fmt.Sprintf("EC2 instance %s was not found", instanceID),
+		)
+		return nil
+	}
+	if err != nil {
+		diags.AddError(
+			"Failed to Describe Instance",
+			fmt.Sprintf("Could not describe EC2 instance %s: %s", instanceID, err),
+		)
+		return nil
+	}
+
+	return instance
+}
+
+// actionStateProgressSink returns an actionwait progress sink that reports the current
+// state of the resource being waited on and the state being waited for.
+func actionStateProgressSink(ctx context.Context, cb fwactions.SendProgressFunc, resource, id string, target any) func(actionwait.FetchResult[any], actionwait.ProgressMeta) {
+	return func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+		cb(ctx, "%s %s is currently in state '%s', continuing to wait for '%s'...", resource, id, fr.Status, target)
+	}
+}
+
+// waitInstanceActionState waits for an EC2 instance to reach the target state, sending
+// progress updates while waiting. The instance must be observed in the target state
+// consecutiveSuccess times in a row.
+func waitInstanceActionState(ctx context.Context, conn *ec2.Client, instanceID string, target awstypes.InstanceStateName, transitional []awstypes.InstanceStateName, consecutiveSuccess int, timeout time.Duration, cb fwactions.SendProgressFunc) error {
+	transitionalStates := make([]actionwait.Status, 0, len(transitional))
+	for _, state := range transitional {
+		transitionalStates = append(transitionalStates, actionwait.Status(state))
+	}
+
+	_, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		instance, err := findInstanceByID(ctx, conn, instanceID)
+		if err != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("describing instance: %w", err)
+		}
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(instance.State.Name)}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(instanceActionPollInterval),
+		ProgressInterval:   instanceActionProgressInterval,
+		ConsecutiveSuccess: consecutiveSuccess,
+		SuccessStates:      []actionwait.Status{actionwait.Status(target)},
+		TransitionalStates: transitionalStates,
+		ProgressSink:       actionStateProgressSink(ctx, cb, "EC2 instance", instanceID, target),
+	})
+
+	return err
+}
+
+// waitInstanceActionStatusChecks waits for both the system and the instance reachability
+// status checks of an EC2 instance to pass, sending progress updates while waiting.
+// The checks must be observed passing consecutiveSuccess times in a row.
+func waitInstanceActionStatusChecks(ctx context.Context, conn *ec2.Client, instanceID string, consecutiveSuccess int, timeout time.Duration, cb fwactions.SendProgressFunc) error {
+	_, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		input := ec2.DescribeInstanceStatusInput{
+			InstanceIds:         []string{instanceID},
+			IncludeAllInstances: aws.Bool(true),
+		}
+		status, err := findInstanceStatus(ctx, conn, &input)
+		if err != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("describing instance status: %w", err)
+		}
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(instanceStatusChecksSummary(status))}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(instanceActionPollInterval),
+		ProgressInterval:   instanceActionProgressInterval,
+		ConsecutiveSuccess: consecutiveSuccess,
+		SuccessStates:      []actionwait.Status{actionwait.Status(awstypes.SummaryStatusOk)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.SummaryStatusInitializing),
+			actionwait.Status(awstypes.SummaryStatusInsufficientData),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "EC2 instance %s status checks are currently '%s', continuing to wait for '%s'...", instanceID, fr.Status, awstypes.SummaryStatusOk)
+		},
+	})
+
+	return err
+}
+
+// waitInstanceActionStatusChecksLeaveOk waits up to gracePeriod for the status checks of an
+// EC2 instance to report anything other than ok, returning whether they did. The checks often
+// stay ok throughout a short reboot, so a missed transition is not an error.
+func waitInstanceActionStatusChecksLeaveOk(ctx context.Context, conn *ec2.Client, instanceID string, gracePeriod time.Duration, cb fwactions.SendProgressFunc) (bool, error) {
+	_, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		input := ec2.DescribeInstanceStatusInput{
+			InstanceIds:         []string{instanceID},
+			IncludeAllInstances: aws.Bool(true),
+		}
+		status, err := findInstanceStatus(ctx, conn, &input)
+		if err != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("describing instance status: %w", err)
+		}
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(instanceStatusChecksSummary(status))}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:          gracePeriod,
+		Interval:         actionwait.FixedInterval(instanceActionPollInterval),
+		ProgressInterval: instanceActionProgressInterval,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.SummaryStatusImpaired),
+			actionwait.Status(awstypes.SummaryStatusInitializing),
+			actionwait.Status(awstypes.SummaryStatusInsufficientData),
+			actionwait.Status(awstypes.SummaryStatusNotApplicable),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.SummaryStatusOk),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "EC2 instance %s status checks are still '%s', waiting for the reboot to be reflected...", instanceID, fr.Status)
+		},
+	})
+
+	if actionwait.IsTimeout(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// instanceStatusChecksSummary returns the combined status of an EC2 instance's
+// system and instance reachability status checks. The system status takes precedence
+// unless it is ok.
+func instanceStatusChecksSummary(status *awstypes.InstanceStatus) awstypes.SummaryStatus {
+	system, instance := awstypes.SummaryStatusInsufficientData, awstypes.SummaryStatusInsufficientData
+	if status.SystemStatus != nil {
+		system = status.SystemStatus.Status
+	}
+	if status.InstanceStatus != nil {
+		instance = status.InstanceStatus.Status
+	}
+
+	if system != awstypes.SummaryStatusOk {
+		return system
+	}
+
+	return instance
+}
+
+// addInstanceActionWaitError adds an error diagnostic for an error returned by waitInstanceActionState.
+// verb describes the operation being waited on, e.g. "stop".
+func addInstanceActionWaitError(diags *diag.Diagnostics, err error, instanceID, verb string, timeout time.Duration) {
+	title := strings.ToUpper(verb[:1]) + verb[1:]
+
+	var timeoutErr *actionwait.TimeoutError
+	var unexpectedErr *actionwait.UnexpectedStateError
+	if errors.As(err, &timeoutErr) {
+		diags.AddError(
+			fmt.Sprintf("Timeout Waiting for Instance to %s", title),
+			fmt.Sprintf("EC2 instance %s did not %s within %s: %s", instanceID, verb, timeout, err),
+		)
+	} else if errors.As(err, &unexpectedErr) {
+		diags.AddError(
+			"Unexpected Instance State",
+			fmt.Sprintf("EC2 instance %s entered unexpected state while waiting to %s: %s", instanceID, verb, err),
+		)
+	} else {
+		diags.AddError(
+			fmt.Sprintf("Error Waiting for Instance to %s", title),
+			fmt.Sprintf("Error while waiting for EC2 instance %s to %s: %s", instanceID, verb, err),
+		)
+	}
+}
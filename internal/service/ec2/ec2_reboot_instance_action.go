@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// rebootInstanceGracePeriod bounds how long the reboot action waits for the instance's
+// status checks to reflect the reboot before waiting for them to pass.
+const rebootInstanceGracePeriod = 2 * time.Minute
+
+// @Action(aws_ec2_reboot_instance, name="Reboot Instance")
+func newRebootInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rebootInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*rebootInstanceAction)(nil)
+)
+
+type rebootInstanceAction struct {
+	framework.ActionWithModel[rebootInstanceModel]
+}
+
+type rebootInstanceModel struct {
+	framework.WithRegionModel
+	InstanceID types.String `tfsdk:"instance_id"`
+	Timeout    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rebootInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reboots a running EC2 instance and waits for its system and instance reachability status checks to pass.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrInstanceID: schema.StringAttribute{
+				Description: "The ID of the EC2 instance to reboot",
+				Required:    true,
+				Validators: []validator.String{
+					instanceIDActionValidator(),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the instance status checks to pass after the reboot (default: 600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(3600),
+				},
+			},
+		},
+	}
+}
+
+func (a *rebootInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rebootInstanceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EC2Client(ctx)
+
+	instanceID := fwflex.StringValueFromFramework(ctx, config.InstanceID)
+	timeout := fwactions.TimeoutOr(config.Timeout, 600*time.Second)
+
+	tflog.Info(ctx, "Starting EC2 reboot instance action", map[string]any{
+		names.AttrInstanceID: instanceID,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting reboot operation for EC2 instance %s...", instanceID)
+
+	instance := findInstanceForAction(ctx, conn, instanceID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if currentState := instance.State.Name; currentState != awstypes.InstanceStateNameRunning {
+		resp.Diagnostics.AddError(
+			"Cannot Reboot Instance",
+			fmt.Sprintf("EC2 instance %s is in state '%s' and cannot be rebooted. Instance must be in 'running' state.", instanceID, currentState),
+		)
+		return
+	}
+
+	cb(ctx, "Sending reboot command to EC2 instance %s...", instanceID)
+
+	input := ec2.RebootInstancesInput{
+		InstanceIds: []string{instanceID},
+	}
+
+	_, err := conn.RebootInstances(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Reboot Instance",
+			fmt.Sprintf("Could not reboot EC2 instance %s: %s", instanceID, err),
+		)
+		return
+	}
+
+	cb(ctx, "Reboot command sent to EC2 instance %s, waiting for the reboot to begin...", instanceID)
+
+	// The instance state remains 'running' while the operating system restarts, so wait
+	// on the status checks instead. The checks report the state from before the reboot
+	// until they are next sampled, so first give them a bounded period to leave 'ok'.
+	start := time.Now()
+	left, err := waitInstanceActionStatusChecksLeaveOk(ctx, conn, instanceID, min(rebootInstanceGracePeriod, timeout/2), cb)
+	if err != nil {
+		addInstanceActionWaitError(&resp.Diagnostics, err, instanceID, "reboot", timeout)
+		return
+	}
+	if !left {
+		tflog.Warn(ctx, "EC2 instance status checks did not leave 'ok' after reboot request", map[string]any{
+			names.AttrInstanceID: instanceID,
+		})
+	}
+
+	cb(ctx, "Waiting for EC2 instance %s status checks to pass...", instanceID)
+
+	// Require several consecutive passing observations in case the reboot was not observed.
+	err = waitInstanceActionStatusChecks(ctx, conn, instanceID, 3, timeout-time.Since(start), cb)
+	if err != nil {
+		addInstanceActionWaitError(&resp.Diagnostics, err, instanceID, "reboot", timeout)
+		return
+	}
+
+	cb(ctx, "EC2 instance %s has been successfully rebooted", instanceID)
+
+	tflog.Info(ctx, "EC2 reboot instance action completed successfully", map[string]any{
+		names.AttrInstanceID: instanceID,
+	})
+}
@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ec2_start_instance, name="Start Instance")
+func newStartInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*startInstanceAction)(nil)
+)
+
+type startInstanceAction struct {
+	framework.ActionWithModel[startInstanceModel]
+}
+
+type startInstanceModel struct {
+	framework.WithRegionModel
+	InstanceID types.String `tfsdk:"instance_id"`
+	Timeout    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *startInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a stopped EC2 instance and waits for it to reach the running state.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrInstanceID: schema.StringAttribute{
+				Description: "The ID of the EC2 instance to start",
+				Required:    true,
+				Validators: []validator.String{
+					instanceIDActionValidator(),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the instance to start (default: 600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(3600),
+				},
+			},
+		},
+	}
+}
+
+func (a *startInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startInstanceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EC2Client(ctx)
+
+	instanceID := fwflex.StringValueFromFramework(ctx, config.InstanceID)
+	timeout := fwactions.TimeoutOr(config.Timeout, 600*time.Second)
+
+	tflog.Info(ctx, "Starting EC2 start instance action", map[string]any{
+		names.AttrInstanceID: instanceID,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting start operation for EC2 instance %s...", instanceID)
+
+	instance := findInstanceForAction(ctx, conn, instanceID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentState := instance.State.Name
+	tflog.Debug(ctx, "Current instance state", map[string]any{
+		names.AttrInstanceID: instanceID,
+		names.AttrState:      currentState,
+	})
+
+	if currentState == awstypes.InstanceStateNameRunning {
+		cb(ctx, "EC2 instance %s is already running", instanceID)
+		tflog.Info(ctx, "Instance already running", map[string]any{
+			names.AttrInstanceID: instanceID,
+		})
+		return
+	}
+
+	if !canStartInstance(currentState) {
+		resp.Diagnostics.AddError(
+			"Cannot Start Instance",
+			fmt.Sprintf("EC2 instance %s is in state '%s' and cannot be started. Instance must be in 'stopped' or 'pending' state.", instanceID, currentState),
+		)
+		return
+	}
+
+	// If instance is already pending, just wait for it
+	if currentState == awstypes.InstanceStateNamePending {
+		cb(ctx, "EC2 instance %s is already starting, waiting for completion...", instanceID)
+	} else {
+		cb(ctx, "Sending start command to EC2 instance %s...", instanceID)
+
+		input := ec2.StartInstancesInput{
+			InstanceIds: []string{instanceID},
+		}
+
+		_, err := conn.StartInstances(ctx, &input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Start Instance",
+				fmt.Sprintf("Could not start EC2 instance %s: %s", instanceID, err),
+			)
+			return
+		}
+
+		cb(ctx, "Start command sent to EC2 instance %s, waiting for instance to start...", instanceID)
+	}
+
+	err := waitInstanceActionState(ctx, conn, instanceID, awstypes.InstanceStateNameRunning, []awstypes.InstanceStateName{
+		awstypes.InstanceStateNamePending,
+		awstypes.InstanceStateNameStopped,
+	}, 1, timeout, cb)
+	if err != nil {
+		addInstanceActionWaitError(&resp.Diagnostics, err, instanceID, "start", timeout)
+		return
+	}
+
+	cb(ctx, "EC2 instance %s has been successfully started", instanceID)
+
+	tflog.Info(ctx, "EC2 start instance action completed successfully", map[string]any{
+		names.AttrInstanceID: instanceID,
+	})
+}
+
+// canStartInstance checks if an instance can be started based on its current state
+func canStartInstance(state awstypes.InstanceStateName) bool {
+	switch state {
+	case awstypes.InstanceStateNameStopped, awstypes.InstanceStateNamePending:
+		return true
+	default:
+		return false
+	}
+}
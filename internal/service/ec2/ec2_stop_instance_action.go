@@ -5,32 +5,24 @@ package ec2
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
-	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
-	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// stopInstancePollInterval defines polling cadence for stop instance action.
-const stopInstancePollInterval = 10 * time.Second
-
 // @Action(aws_ec2_stop_instance, name="Stop Instance")
 func newStopInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
 	return &stopInstanceAction{}, nil
@@ -48,6 +40,7 @@ type stopInstanceModel struct {
 	framework.WithRegionModel
 	InstanceID types.String `tfsdk:"instance_id"`
 	Force      types.Bool   `tfsdk:"force"`
+	Hibernate  types.Bool   `tfsdk:"hibernate"`
 	Timeout    types.Int64  `tfsdk:"timeout"`
 }
 
@@ -59,16 +52,17 @@ func (a *stopInstanceAction) Schema(ctx context.Context, req action.SchemaReques
 				Description: "The ID of the EC2 instance to stop",
 				Required:    true,
 				Validators: []validator.String{
-					stringvalidator.RegexMatches(
-						regexache.MustCompile(`^i-[0-9a-f]{8,17}$`),
-						"must be a valid EC2 instance ID (e.g., i-1234567890abcdef0)",
-					),
+					instanceIDActionValidator(),
 				},
 			},
 			"force": schema.BoolAttribute{
 				Description: "Forces the instance to stop. The instance does not have an opportunity to flush file system caches or file system metadata. If you use this option, you must perform file system check and repair procedures. This option is not recommended for Windows instances.",
 				Optional:    true,
 			},
+			"hibernate": schema.BoolAttribute{
+				Description: "Hibernates the instance if the instance was enabled for hibernation at launch. If the instance cannot hibernate successfully, a normal shutdown occurs.",
+				Optional:    true,
+			},
 			names.AttrTimeout: schema.Int64Attribute{
 				Description: "Timeout in seconds to wait for the instance to stop (default: 600)",
 				Optional:    true,
@@ -95,6 +89,7 @@ func (a *stopInstanceAction) Invoke(ctx context.Context, req action.InvokeReques
 
 	instanceID := fwflex.StringValueFromFramework(ctx, config.InstanceID)
 	force := fwflex.BoolValueFromFramework(ctx, config.Force)
+	hibernate := fwflex.BoolValueFromFramework(ctx, config.Hibernate)
 
 	// Set default timeout if not provided
 	timeout := fwactions.TimeoutOr(config.Timeout, 600*time.Second)
@@ -102,6 +97,7 @@ func (a *stopInstanceAction) Invoke(ctx context.Context, req action.InvokeReques
 	tflog.Info(ctx, "Starting EC2 stop instance action", map[string]any{
 		names.AttrInstanceID: instanceID,
 		"force":              force,
+		"hibernate":          hibernate,
 		names.AttrTimeout:    timeout.String(),
 	})
 
@@ -110,19 +106,8 @@ func (a *stopInstanceAction) Invoke(ctx context.Context, req action.InvokeReques
 	cb(ctx, "Starting stop operation for EC2 instance %s...", instanceID)
 
 	// Check current instance state first
-	instance, err := findInstanceByID(ctx, conn, instanceID)
-	if retry.NotFound(err) {
-		resp.Diagnostics.AddError(
-			"Instance Not Found",
-			fmt.Sprintf("EC2 instance %s was not found", instanceID),
-		)
-		return
-	}
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to Describe Instance",
-			fmt.Sprintf("Could not describe EC2 instance %s: %s", instanceID, err),
-		)
+	instance := findInstanceForAction(ctx, conn, instanceID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -159,10 +144,11 @@ func (a *stopInstanceAction) Invoke(ctx context.Context, req action.InvokeReques
 
 		input := ec2.StopInstancesInput{
 			Force:       aws.Bool(force),
+			Hibernate:   aws.Bool(hibernate),
 			InstanceIds: []string{instanceID},
 		}
 
-		_, err = conn.StopInstances(ctx, &input)
+		_, err := conn.StopInstances(ctx, &input)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Failed to Stop Instance",
@@ -174,49 +160,14 @@ func (a *stopInstanceAction) Invoke(ctx context.Context, req action.InvokeReques
 		cb(ctx, "Stop command sent to EC2 instance %s, waiting for instance to stop...", instanceID)
 	}
 
-	// Wait for instance to stop with periodic progress updates using actionwait
-	// Use fixed interval since EC2 instance state transitions are predictable and
-	// relatively quick - consistent polling every 10s is optimal for this operation
-	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
-		instance, derr := findInstanceByID(ctx, conn, instanceID)
-		if derr != nil {
-			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("describing instance: %w", derr)
-		}
-		state := string(instance.State.Name)
-		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(state)}, nil
-	}, actionwait.Options[struct{}]{
-		Timeout:          timeout,
-		Interval:         actionwait.FixedInterval(stopInstancePollInterval),
-		ProgressInterval: 30 * time.Second,
-		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.InstanceStateNameStopped)},
-		TransitionalStates: []actionwait.Status{
-			actionwait.Status(awstypes.InstanceStateNameRunning),
-			actionwait.Status(awstypes.InstanceStateNameStopping),
-			actionwait.Status(awstypes.InstanceStateNameShuttingDown),
-		},
-		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
-			cb(ctx, "EC2 instance %s is currently in state '%s', continuing to wait for 'stopped'...", instanceID, fr.Status)
-		},
-	})
+	// Wait for instance to stop with periodic progress updates
+	err := waitInstanceActionState(ctx, conn, instanceID, awstypes.InstanceStateNameStopped, []awstypes.InstanceStateName{
+		awstypes.InstanceStateNameRunning,
+		awstypes.InstanceStateNameStopping,
+		awstypes.InstanceStateNameShuttingDown,
+	}, 1, timeout, cb)
 	if err != nil {
-		var timeoutErr *actionwait.TimeoutError
-		var unexpectedErr *actionwait.UnexpectedStateError
-		if errors.As(err, &timeoutErr) {
-			resp.Diagnostics.AddError(
-				"Timeout Waiting for Instance to Stop",
-				fmt.Sprintf("EC2 instance %s did not stop within %s: %s", instanceID, timeout, err),
-			)
-		} else if errors.As(err, &unexpectedErr) {
-			resp.Diagnostics.AddError(
-				"Unexpected Instance State",
-				fmt.Sprintf("EC2 instance %s entered unexpected state while stopping: %s", instanceID, err),
-			)
-		} else {
-			resp.Diagnostics.AddError(
-				"Error Waiting for Instance to Stop",
-				fmt.Sprintf("Error while waiting for EC2 instance %s to stop: %s", instanceID, err),
-			)
-		}
+		addInstanceActionWaitError(&resp.Diagnostics, err, instanceID, "stop", timeout)
 		return
 	}
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -90,6 +91,36 @@ func TestAccEC2StopInstanceAction_trigger(t *testing.T) {
 	})
 }
 
+func TestAccEC2StopInstanceAction_hibernate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EC2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStopInstanceActionConfig_hibernate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExistsLocal(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "hibernation", acctest.CtTrue),
+					testAccCheckInstanceState(ctx, t, resourceName, awstypes.InstanceStateNameStopped),
+					testAccCheckInstanceStateReason(ctx, t, resourceName, "Client.UserInitiatedHibernate"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceExistsLocal(ctx context.Context, t *testing.T, n string, v *awstypes.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -140,6 +171,32 @@ func testAccCheckInstanceState(ctx context.Context, t *testing.T, n string, expe
 	}
 }
 
+func testAccCheckInstanceStateReason(ctx context.Context, t *testing.T, n, expectedCode string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).EC2Client(ctx)
+
+		instance, err := tfec2.FindInstanceByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if instance.StateReason == nil {
+			return fmt.Errorf("Expected instance %s state reason %s, got none", rs.Primary.ID, expectedCode)
+		}
+
+		if actualCode := aws.ToString(instance.StateReason.Code); actualCode != expectedCode {
+			return fmt.Errorf("Expected instance %s state reason %s, got %s", rs.Primary.ID, expectedCode, actualCode)
+		}
+
+		return nil
+	}
+}
+
 func testAccStopInstanceActionConfig_force(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
@@ -198,6 +255,47 @@ resource "terraform_data" "trigger" {
 `, rName))
 }
 
+func testAccStopInstanceActionConfig_hibernate(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.ConfigVPCWithSubnets(rName, 1),
+		fmt.Sprintf(`
+# must be >= m3 and have an encrypted root volume to enable hibernation
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  hibernation   = true
+  instance_type = "m5.large"
+  subnet_id     = aws_subnet.test[0].id
+
+  root_block_device {
+    encrypted   = true
+    volume_size = 20
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+action "aws_ec2_stop_instance" "test" {
+  config {
+    instance_id = aws_instance.test.id
+    hibernate   = true
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ec2_stop_instance.test]
+    }
+  }
+}
+`, rName))
+}
+
 // Step 1: Get the AWS provider as a ProviderServerWithActions
 func providerWithActions(ctx context.Context, t *testing.T) tfprotov5.ProviderServerWithActions { //nolint:staticcheck // SA1019: Working in alpha situation
 	t.Helper()
@@ -281,6 +379,7 @@ func buildStopInstanceActionConfig(instanceID string, force bool) (tftypes.Type,
 		AttributeTypes: map[string]tftypes.Type{
 			names.AttrInstanceID: tftypes.String,
 			"force":              tftypes.Bool,
+			"hibernate":          tftypes.Bool,
 			names.AttrTimeout:    tftypes.Number,
 			names.AttrRegion:     tftypes.String,
 		},
@@ -289,6 +388,7 @@ func buildStopInstanceActionConfig(instanceID string, force bool) (tftypes.Type,
 	config := map[string]tftypes.Value{
 		names.AttrInstanceID: tftypes.NewValue(tftypes.String, instanceID),
 		"force":              tftypes.NewValue(tftypes.Bool, force),
+		"hibernate":          tftypes.NewValue(tftypes.Bool, nil),
 		names.AttrTimeout:    tftypes.NewValue(tftypes.Number, nil),
 		names.AttrRegion:     tftypes.NewValue(tftypes.String, nil),
 	}
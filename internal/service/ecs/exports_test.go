@@ -19,6 +19,7 @@ var (
 
 	ClusterNameFromARN                      = clusterNameFromARN
 	DaemonNameFromARN                       = daemonNameFromARN
+	DeploymentRolloutStatus                 = deploymentRolloutStatus
 	FindCapacityProviderByARN               = findCapacityProviderByARN
 	FindClusterByNameOrARN                  = findClusterByNameOrARN
 	FindDaemonByARN                         = findDaemonByARN
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// forceNewDeploymentPollInterval defines polling cadence for the force new deployment action.
+	forceNewDeploymentPollInterval = 15 * time.Second
+
+	// deploymentRolloutStateSuperseded is reported when the deployment being waited on
+	// is no longer one of the service's deployments.
+	deploymentRolloutStateSuperseded = "SUPERSEDED"
+)
+
+// @Action(aws_ecs_force_new_deployment, name="Force New Deployment")
+func newForceNewDeploymentAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &forceNewDeploymentAction{}, nil
+}
+
+var (
+	_ action.Action = (*forceNewDeploymentAction)(nil)
+)
+
+type forceNewDeploymentAction struct {
+	framework.ActionWithModel[forceNewDeploymentModel]
+}
+
+type forceNewDeploymentModel struct {
+	framework.WithRegionModel
+	Cluster types.String `tfsdk:"cluster"`
+	Service types.String `tfsdk:"service"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *forceNewDeploymentAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a new deployment of an ECS service using the service's current configuration and waits for the service to reach a steady state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "The name or ARN of the ECS cluster that hosts the service",
+				Required:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "The name or ARN of the ECS service to redeploy",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the service to reach a steady state (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *forceNewDeploymentAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config forceNewDeploymentModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := fwflex.StringValueFromFramework(ctx, config.Cluster)
+	service := fwflex.StringValueFromFramework(ctx, config.Service)
+	timeout := fwactions.TimeoutOr(config.Timeout, 1800*time.Second)
+
+	tflog.Info(ctx, "Starting ECS force new deployment action", map[string]any{
+		"cluster":         cluster,
+		"service":         service,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Forcing new deployment of ECS service %s in cluster %s...", service, cluster)
+
+	input := ecs.UpdateServiceInput{
+		Cluster:            aws.String(cluster),
+		ForceNewDeployment: true,
+		Service:            aws.String(service),
+	}
+
+	output, err := conn.UpdateService(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Force New Deployment",
+			fmt.Sprintf("Could not force new deployment of ECS service %s: %s", service, err),
+		)
+		return
+	}
+
+	deployment := findPrimaryTaskSet(output.Service.Deployments)
+	if deployment == nil {
+		resp.Diagnostics.AddError(
+			"Failed to Force New Deployment",
+			fmt.Sprintf("ECS service %s has no primary deployment", service),
+		)
+		return
+	}
+	deploymentID := aws.ToString(deployment.Id)
+
+	cb(ctx, "Deployment %s started, waiting for ECS service %s to reach a steady state...", deploymentID, service)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Service], error) {
+		output, err := findServiceNoTagsByTwoPartKey(ctx, conn, service, cluster)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Service]{}, fmt.Errorf("describing service: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.Service]{Status: deploymentRolloutStatus(output, deploymentID), Value: output}, nil
+	}, actionwait.Options[*awstypes.Service]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(forceNewDeploymentPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.DeploymentRolloutStateCompleted)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateFailed),
+			deploymentRolloutStateSuperseded,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if service, ok := fr.Value.(*awstypes.Service); ok {
+				cb(ctx, "ECS service %s deployment %s is %s (%d of %d tasks running)", aws.ToString(service.ServiceName), deploymentID, fr.Status, service.RunningCount, service.DesiredCount)
+			}
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Deployment",
+				fmt.Sprintf("ECS service %s did not reach a steady state within %s: %s", service, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Deployment Failed",
+				fmt.Sprintf("ECS service %s deployment %s did not complete: %s", service, deploymentID, deploymentRolloutStateReason(result.Value, deploymentID, failureErr.Status)),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Deployment State",
+				fmt.Sprintf("ECS service %s deployment %s entered unexpected state: %s", service, deploymentID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Deployment",
+				fmt.Sprintf("Error while waiting for ECS service %s to reach a steady state: %s", service, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "ECS service %s has reached a steady state", service)
+
+	tflog.Info(ctx, "ECS force new deployment action completed successfully", map[string]any{
+		"cluster":       cluster,
+		"service":       service,
+		"deployment_id": deploymentID,
+	})
+}
+
+// deploymentRolloutStatus returns the rollout state of the specified deployment of an ECS service.
+// Deployments that don't report a rollout state, e.g. those behind a Classic Load Balancer,
+// are complete once they are the service's only deployment and all desired tasks are running.
+func deploymentRolloutStatus(service *awstypes.Service, deploymentID string) actionwait.Status {
+	for _, deployment := range service.Deployments {
+		if aws.ToString(deployment.Id) != deploymentID {
+			continue
+		}
+
+		if deployment.RolloutState != "" {
+			return actionwait.Status(deployment.RolloutState)
+		}
+
+		if len(service.Deployments) == 1 && deployment.RunningCount == deployment.DesiredCount {
+			return actionwait.Status(awstypes.DeploymentRolloutStateCompleted)
+		}
+
+		return actionwait.Status(awstypes.DeploymentRolloutStateInProgress)
+	}
+
+	return deploymentRolloutStateSuperseded
+}
+
+func deploymentRolloutStateReason(service *awstypes.Service, deploymentID string, status actionwait.Status) string {
+	if service != nil {
+		for _, deployment := range service.Deployments {
+			if aws.ToString(deployment.Id) == deploymentID && deployment.RolloutStateReason != nil {
+				return aws.ToString(deployment.RolloutStateReason)
+			}
+		}
+	}
+
+	if status == deploymentRolloutStateSuperseded {
+		return "superseded by a later deployment"
+	}
+
+	return string(status)
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDeploymentRolloutStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		service *awstypes.Service
+		want    actionwait.Status
+	}{
+		"rollout state": {
+			service: &awstypes.Service{
+				Deployments: []awstypes.Deployment{
+					{Id: aws.String("ecs-svc/2"), RolloutState: awstypes.DeploymentRolloutStateInProgress},
+					{Id: aws.String("ecs-svc/1"), RolloutState: awstypes.DeploymentRolloutStateCompleted},
+				},
+			},
+			want: actionwait.Status(awstypes.DeploymentRolloutStateInProgress),
+		},
+		"failed": {
+			service: &awstypes.Service{
+				Deployments: []awstypes.Deployment{
+					{Id: aws.String("ecs-svc/2"), RolloutState: awstypes.DeploymentRolloutStateFailed},
+				},
+			},
+			want: actionwait.Status(awstypes.DeploymentRolloutStateFailed),
+		},
+		"no rollout state, old deployment draining": {
+			service: &awstypes.Service{
+				Deployments: []awstypes.Deployment{
+					{Id: aws.String("ecs-svc/2"), DesiredCount: 2, RunningCount: 2},
+					{Id: aws.String("ecs-svc/1"), DesiredCount: 0, RunningCount: 1},
+				},
+			},
+			want: actionwait.Status(awstypes.DeploymentRolloutStateInProgress),
+		},
+		"no rollout state, tasks starting": {
+			service: &awstypes.Service{
+				Deployments: []awstypes.Deployment{
+					{Id: aws.String("ecs-svc/2"), DesiredCount: 2, RunningCount: 1},
+				},
+			},
+			want: actionwait.Status(awstypes.DeploymentRolloutStateInProgress),
+		},
+		"no rollout state, steady": {
+			service: &awstypes.Service{
+				Deployments: []awstypes.Deployment{
+					{Id: aws.String("ecs-svc/2"), DesiredCount: 2, RunningCount: 2},
+				},
+			},
+			want: actionwait.Status(awstypes.DeploymentRolloutStateCompleted),
+		},
+		"superseded": {
+			service: &awstypes.Service{
+				Deployments: []awstypes.Deployment{
+					{Id: aws.String("ecs-svc/3"), RolloutState: awstypes.DeploymentRolloutStateInProgress},
+				},
+			},
+			want: "SUPERSEDED",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfecs.DeploymentRolloutStatus(testCase.service, "ecs-svc/2"), testCase.want; got != want {
+				t.Errorf("DeploymentRolloutStatus() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccECSForceNewDeploymentAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckServiceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccForceNewDeploymentActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckForceNewDeploymentActionDeployed(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckForceNewDeploymentActionDeployed checks that the service has a completed deployment
+// in addition to the deployment made when the service was created.
+func testAccCheckForceNewDeploymentActionDeployed(ctx context.Context, t *testing.T, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ECSClient(ctx)
+
+		input := ecs.ListServiceDeploymentsInput{
+			Cluster: aws.String(rName),
+			Service: aws.String(rName),
+		}
+		output, err := conn.ListServiceDeployments(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		if n := len(output.ServiceDeployments); n < 2 {
+			return fmt.Errorf("ECS Service (%s) has %d deployments, want at least 2", rName, n)
+		}
+
+		service, err := tfecs.FindServiceNoTagsByTwoPartKey(ctx, conn, rName, rName)
+		if err != nil {
+			return err
+		}
+
+		for _, deployment := range service.Deployments {
+			if aws.ToString(deployment.Status) != "PRIMARY" {
+				continue
+			}
+
+			if got, want := tfecs.DeploymentRolloutStatus(service, aws.ToString(deployment.Id)), actionwait.Status(awstypes.DeploymentRolloutStateCompleted); got != want {
+				return fmt.Errorf("ECS Service (%s) primary deployment rollout state = %s, want %s", rName, got, want)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("ECS Service (%s) has no primary deployment", rName)
+	}
+}
+
+func testAccForceNewDeploymentActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = jsonencode([{
+    cpu       = 128
+    essential = true
+    image     = "public.ecr.aws/docker/library/busybox:latest"
+    memory    = 128
+    name      = "busybox"
+  }])
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.arn
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 0
+}
+
+action "aws_ecs_force_new_deployment" "test" {
+  config {
+    cluster = aws_ecs_cluster.test.name
+    service = aws_ecs_service.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ecs_force_new_deployment.test]
+    }
+  }
+}
+`, rName)
+}
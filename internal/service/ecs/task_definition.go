@@ -40,6 +40,7 @@ import (
 
 // @SDKResource("aws_ecs_task_definition", name="Task Definition")
 // @Tags(identifierAttribute="arn")
+// @AssumeRole(overrideEnabled=false)
 // @IdentityAttribute("family")
 // @IdentityAttribute("revision", valueType="int")
 // @MutableIdentity
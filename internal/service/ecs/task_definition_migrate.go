@@ -17,6 +17,7 @@ import (
 )
 
 func resourceTaskDefinitionMigrateState(v int, is *terraform.InstanceState, meta any) (*terraform.InstanceState, error) {
+	// Legacy state migrations have no request context, so no per-resource IAM role override is in effect.
 	ctx := context.Background()
 	conn := meta.(*conns.AWSClient).ECSClient(ctx)
 
@@ -32,6 +32,7 @@ var (
 	WaitCacheClusterAvailable            = waitCacheClusterAvailable
 	WaitReplicationGroupAvailable        = waitReplicationGroupAvailable
 
+	BuildIAMAuthToken                                 = buildIAMAuthToken
 	DeleteCacheCluster                                = deleteCacheCluster
 	DiffVersion                                       = diffVersion
 	EmptyDescription                                  = emptyDescription
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/iamauth"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameIAMAuthToken = "Ephemeral Resource IAM Auth Token"
+
+	// iamAuthTokenSigningName is the SigV4 signing name of an IAM authentication token.
+	iamAuthTokenSigningName = "elasticache"
+)
+
+// @EphemeralResource(aws_elasticache_iam_auth_token, name="IAM Auth Token")
+func newIAMAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &iamAuthTokenEphemeralResource{}, nil
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigValidators = &iamAuthTokenEphemeralResource{}
+)
+
+type iamAuthTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[iamAuthTokenEphemeralResourceModel]
+}
+
+func (e *iamAuthTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"replication_group_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"serverless_cache_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *iamAuthTokenEphemeralResource) ConfigValidators(context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot("replication_group_id"),
+			path.MatchRoot("serverless_cache_name"),
+		),
+	}
+}
+
+func (e *iamAuthTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data iamAuthTokenEphemeralResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	cacheName, serverless := data.ReplicationGroupID.ValueString(), false
+	if !data.ServerlessCacheName.IsNull() {
+		cacheName, serverless = data.ServerlessCacheName.ValueString(), true
+	}
+
+	token, err := buildIAMAuthToken(ctx, cacheName, serverless, data.UserName.ValueString(), e.Meta().Region(ctx), e.Meta().CredentialsProvider(ctx), time.Now())
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ElastiCache, create.ErrActionReading, ERNameIAMAuthToken, cacheName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// buildIAMAuthToken signs an IAM authentication token for the specified user and replication group or serverless cache.
+// See https://docs.aws.amazon.com/AmazonElastiCache/latest/dg/auth-iam.html.
+func buildIAMAuthToken(ctx context.Context, cacheName string, serverless bool, userName, region string, credentialsProvider aws.CredentialsProvider, signingTime time.Time) (string, error) {
+	var params url.Values
+	if serverless {
+		params = url.Values{"ResourceType": []string{"ServerlessCache"}}
+	}
+
+	return iamauth.BuildToken(ctx, iamAuthTokenSigningName, cacheName, userName, region, credentialsProvider, signingTime, params)
+}
+
+type iamAuthTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ReplicationGroupID  types.String `tfsdk:"replication_group_id"`
+	ServerlessCacheName types.String `tfsdk:"serverless_cache_name"`
+	Token               types.String `tfsdk:"token"`
+	UserName            types.String `tfsdk:"user_name"`
+}
@@ -2377,7 +2377,7 @@ func testAccCheckListenerDestroy(ctx context.Context, t *testing.T) resource.Tes
 				continue
 			}
 
-			ctx = conns.NewResourceContext(ctx, "", "", "", rs.Primary.Attributes[names.AttrRegion])
+			ctx = conns.NewResourceContext(ctx, "", "", "", rs.Primary.Attributes[names.AttrRegion], "")
 			conn := acctest.ProviderMeta(ctx, t).ELBV2Client(ctx)
 
 			_, err := tfelbv2.FindListenerByARN(ctx, conn, rs.Primary.ID)
@@ -544,7 +544,7 @@ func testAccCheckTargetGroupAttachmentDestroy(ctx context.Context, t *testing.T)
 			}
 
 			// To support region override testing
-			ctx := conns.NewResourceContext(ctx, "", "", "", rs.Primary.Attributes[names.AttrRegion])
+			ctx := conns.NewResourceContext(ctx, "", "", "", rs.Primary.Attributes[names.AttrRegion], "")
 			conn := acctest.ProviderMeta(ctx, t).ELBV2Client(ctx)
 
 			input := &elasticloadbalancingv2.DescribeTargetHealthInput{
@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startJobRunPollInterval defines polling cadence for the start job run action.
+const startJobRunPollInterval = 15 * time.Second
+
+// @Action(aws_glue_start_job_run, name="Start Job Run")
+func newStartJobRunAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startJobRunAction{}, nil
+}
+
+var (
+	_ action.Action = (*startJobRunAction)(nil)
+)
+
+type startJobRunAction struct {
+	framework.ActionWithModel[startJobRunModel]
+}
+
+type startJobRunModel struct {
+	framework.WithRegionModel
+	Arguments       fwtypes.MapOfString                     `tfsdk:"arguments"`
+	JobName         types.String                            `tfsdk:"job_name"`
+	NumberOfWorkers types.Int64                             `tfsdk:"number_of_workers"`
+	Timeout         types.Int64                             `tfsdk:"timeout"`
+	WorkerType      fwtypes.StringEnum[awstypes.WorkerType] `tfsdk:"worker_type"`
+}
+
+func (a *startJobRunAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a run of a Glue job and waits for the job run to finish.",
+		Attributes: map[string]schema.Attribute{
+			"arguments": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Description: "Job arguments for this run. These replace the default arguments set in the job definition",
+				Optional:    true,
+			},
+			"job_name": schema.StringAttribute{
+				Description: "The name of the Glue job to run",
+				Required:    true,
+			},
+			"number_of_workers": schema.Int64Attribute{
+				Description: "The number of workers allocated to this run. Defaults to the number configured in the job definition",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the job run to finish (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(172800),
+				},
+			},
+			"worker_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.WorkerType](),
+				Description: "The type of worker allocated to this run. Defaults to the type configured in the job definition",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *startJobRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startJobRunModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().GlueClient(ctx)
+
+	jobName := fwflex.StringValueFromFramework(ctx, config.JobName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 3600*time.Second)
+
+	tflog.Info(ctx, "Starting Glue start job run action", map[string]any{
+		"job_name":        jobName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting run of Glue job %s...", jobName)
+
+	input := glue.StartJobRunInput{
+		Arguments:       fwflex.ExpandFrameworkStringValueMap(ctx, config.Arguments),
+		JobName:         aws.String(jobName),
+		NumberOfWorkers: fwflex.Int32FromFrameworkInt64(ctx, config.NumberOfWorkers),
+		WorkerType:      config.WorkerType.ValueEnum(),
+	}
+
+	output, err := conn.StartJobRun(ctx, &input)
+	if errs.IsA[*awstypes.ConcurrentRunsExceededException](err) {
+		resp.Diagnostics.AddError(
+			"Job Run Already In Progress",
+			fmt.Sprintf("Glue job %s has reached its maximum number of concurrent runs: %s", jobName, err),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Job Run",
+			fmt.Sprintf("Could not start run of Glue job %s: %s", jobName, err),
+		)
+		return
+	}
+
+	runID := aws.ToString(output.JobRunId)
+
+	cb(ctx, "Glue job run %s started, waiting for it to finish...", runID)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.JobRun], error) {
+		output, err := findJobRunByTwoPartKey(ctx, conn, jobName, runID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.JobRun]{}, fmt.Errorf("describing job run: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.JobRun]{Status: actionwait.Status(output.JobRunState), Value: output}, nil
+	}, actionwait.Options[*awstypes.JobRun]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startJobRunPollInterval),
+		ProgressInterval: time.Minute,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.JobRunStateSucceeded)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateRunning),
+			actionwait.Status(awstypes.JobRunStateStarting),
+			actionwait.Status(awstypes.JobRunStateStopping),
+			actionwait.Status(awstypes.JobRunStateWaiting),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateError),
+			actionwait.Status(awstypes.JobRunStateExpired),
+			actionwait.Status(awstypes.JobRunStateFailed),
+			actionwait.Status(awstypes.JobRunStateStopped),
+			actionwait.Status(awstypes.JobRunStateTimeout),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if jobRun, ok := fr.Value.(*awstypes.JobRun); ok && jobRun != nil {
+				cb(ctx, "Glue job run %s is %s (execution time: %ds)", runID, fr.Status, jobRun.ExecutionTime)
+			} else {
+				cb(ctx, "Glue job run %s is %s", runID, fr.Status)
+			}
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Job Run",
+				fmt.Sprintf("Glue job %s run %s did not finish within %s: %s", jobName, runID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			reason := string(failureErr.Status)
+			if result.Value != nil && result.Value.ErrorMessage != nil {
+				reason = fmt.Sprintf("%s: %s", reason, aws.ToString(result.Value.ErrorMessage))
+			}
+			resp.Diagnostics.AddError(
+				"Job Run Failed",
+				fmt.Sprintf("Glue job %s run %s did not succeed: %s", jobName, runID, reason),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Job Run State",
+				fmt.Sprintf("Glue job %s run %s entered unexpected state: %s", jobName, runID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Job Run",
+				fmt.Sprintf("Error while waiting for Glue job %s run %s: %s", jobName, runID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Glue job run %s of job %s completed successfully", runID, jobName)
+
+	tflog.Info(ctx, "Glue start job run action completed successfully", map[string]any{
+		"job_name":   jobName,
+		"job_run_id": runID,
+	})
+}
+
+func findJobRunByTwoPartKey(ctx context.Context, conn *glue.Client, jobName, runID string) (*awstypes.JobRun, error) {
+	input := glue.GetJobRunInput{
+		JobName: aws.String(jobName),
+		RunId:   aws.String(runID),
+	}
+
+	output, err := conn.GetJobRun(ctx, &input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobRun == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.JobRun, nil
+}
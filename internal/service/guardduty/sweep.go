@@ -7,22 +7,22 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
-	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
 		Name:         "aws_guardduty_detector",
 		F:            sweepDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
@@ -38,7 +38,7 @@ func sweepDetectors(region string) error {
 
 	conn := client.GuardDutyClient(ctx)
 	input := &guardduty.ListDetectorsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	pages := guardduty.NewListDetectorsPaginator(conn, input)
 
@@ -55,25 +55,19 @@ func sweepDetectors(region string) error {
 		}
 
 		for _, detectorID := range page.DetectorIds {
-			input := &guardduty.DeleteDetectorInput{
-				DetectorId: &detectorID,
-			}
+			r := resourceDetector()
+			d := r.Data(nil)
+			d.SetId(detectorID)
 
-			log.Printf("[INFO] Deleting GuardDuty Detector: %s", detectorID)
-			_, err := conn.DeleteDetector(ctx, input)
-			if tfawserr.ErrCodeContains(err, "AccessDenied") {
-				log.Printf("[WARN] Skipping GuardDuty Detector (%s): %s", detectorID, err)
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting GuardDuty Detector (%s): %w", detectorID, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
 		}
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping GuardDuty Detectors (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepPublishingDestinations(region string) error {
@@ -85,7 +79,7 @@ func sweepPublishingDestinations(region string) error {
 	}
 
 	conn := client.GuardDutyClient(ctx)
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	detect_input := &guardduty.ListDetectorsInput{}
 
@@ -94,10 +88,13 @@ func sweepPublishingDestinations(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping GuardDuty Publishing Destination sweep for %s: %s", region, err)
+			return nil
+		}
+
 		if err != nil {
-			sweeperErr := fmt.Errorf("Error receiving Guardduty detectors for publishing sweep : %w", err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			return fmt.Errorf("Error receiving Guardduty detectors for publishing sweep : %w", err)
 		}
 
 		for _, detectorID := range page.DetectorIds {
@@ -120,23 +117,19 @@ func sweepPublishingDestinations(region string) error {
 				}
 
 				for _, destination_element := range page.Destinations {
-					input := &guardduty.DeletePublishingDestinationInput{
-						DestinationId: destination_element.DestinationId,
-						DetectorId:    &detectorID,
-					}
-
-					log.Printf("[INFO] Deleting GuardDuty Publishing Destination: %s", *destination_element.DestinationId)
-					_, err := conn.DeletePublishingDestination(ctx, input)
-
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting GuardDuty Publishing Destination (%s): %w", *destination_element.DestinationId, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					}
+					r := resourcePublishingDestination()
+					d := r.Data(nil)
+					d.SetId(publishingDestinationCreateResourceID(detectorID, aws.ToString(destination_element.DestinationId)))
+
+					sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
 				}
 			}
 		}
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping GuardDuty Publishing Destinations (%s): %w", region, err)
+	}
+
+	return nil
 }
@@ -48,6 +48,7 @@ const (
 )
 
 // @SDKResource("aws_iam_role", name="Role")
+// @AssumeRole
 // @Tags(identifierAttribute="name", resourceType="Role")
 // @IdentityAttribute("name")
 // @CustomImport
@@ -30,6 +30,7 @@ import (
 )
 
 // @FrameworkResource("aws_iam_role_policy_attachments_exclusive", name="Role Policy Attachments Exclusive")
+// @AssumeRole
 func newRolePolicyAttachmentsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &rolePolicyAttachmentsExclusiveResource{}, nil
 }
@@ -209,6 +210,7 @@ func findRolePolicyAttachmentsByName(ctx context.Context, conn *iam.Client, role
 }
 
 type rolePolicyAttachmentsExclusiveResourceModel struct {
+	framework.WithAssumeRoleARNModel
 	RoleName   types.String        `tfsdk:"role_name"`
 	PolicyARNs fwtypes.SetOfString `tfsdk:"policy_arns"`
 }
@@ -82,10 +82,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Region:   inttypes.ResourceRegionDisabled(),
 		},
 		{
-			Factory:  newRolePolicyAttachmentsExclusiveResource,
-			TypeName: "aws_iam_role_policy_attachments_exclusive",
-			Name:     "Role Policy Attachments Exclusive",
-			Region:   inttypes.ResourceRegionDisabled(),
+			Factory:    newRolePolicyAttachmentsExclusiveResource,
+			TypeName:   "aws_iam_role_policy_attachments_exclusive",
+			Name:       "Role Policy Attachments Exclusive",
+			Region:     inttypes.ResourceRegionDisabled(),
+			AssumeRole: inttypes.ResourceAssumeRoleOverride(),
 		},
 		{
 			Factory:  newUserPoliciesExclusiveResource,
@@ -331,7 +332,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 				IdentifierAttribute: names.AttrName,
 				ResourceType:        "Role",
 			}),
-			Region: inttypes.ResourceRegionDisabled(),
+			Region:     inttypes.ResourceRegionDisabled(),
+			AssumeRole: inttypes.ResourceAssumeRoleOverride(),
 			Identity: inttypes.GlobalSingleParameterIdentity(inttypes.StringIdentityAttribute(names.AttrName, true),
 				inttypes.WithV6_0SDKv2Fix(),
 			),
@@ -28,7 +28,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -42,7 +42,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -56,7 +56,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_auditmanager_assessment",
@@ -94,14 +94,11 @@ func RegisterSweepers() {
 	awsv2.Register("aws_iam_service_specific_credential", sweepServiceSpecificCredentials)
 	awsv2.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
-		Name: "aws_iam_server_certificate",
-		F:    sweepServerCertificates,
-	})
+	awsv2.Register("aws_iam_server_certificate", sweepServerCertificates)
 
 	awsv2.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -116,6 +113,12 @@ func RegisterSweepers() {
 
 func sweepGroups(region string) error {
 	ctx := sweep.Context(region)
+
+	// Users and policies are removed from each group before it is deleted.
+	if skip, err := sweep.SkipDirectSweeper(ctx, "aws_iam_group"); skip || err != nil {
+		return err
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -432,6 +435,12 @@ func (ps policySweeper) Delete(ctx context.Context, optFns ...tfresource.Options
 
 func sweepRoles(region string) error {
 	ctx := sweep.Context(region)
+
+	// Instance profiles and policies are removed from each role before it is deleted.
+	if skip, err := sweep.SkipDirectSweeper(ctx, "aws_iam_role"); skip || err != nil {
+		return err
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -511,41 +520,29 @@ func sweepSAMLProviders(ctx context.Context, client *conns.AWSClient) ([]sweep.S
 	return sweepResources, err
 }
 
-func sweepServerCertificates(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("getting client: %w", err)
-	}
+func sweepServerCertificates(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.IAMClient(ctx)
 
+	var sweepResources []sweep.Sweepable
+
 	pages := iam.NewListServerCertificatesPaginator(conn, &iam.ListServerCertificatesInput{})
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping IAM Server Certificate sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		for _, sc := range page.ServerCertificateMetadataList {
-			log.Printf("[INFO] Deleting IAM Server Certificate: %s", aws.ToString(sc.ServerCertificateName))
+			r := resourceServerCertificate()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(sc.ServerCertificateId))
+			d.Set(names.AttrName, sc.ServerCertificateName)
 
-			_, err := conn.DeleteServerCertificate(ctx, &iam.DeleteServerCertificateInput{
-				ServerCertificateName: sc.ServerCertificateName,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete IAM Server Certificate %s: %s",
-					aws.ToString(sc.ServerCertificateName), err)
-				continue
-			}
+			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
 		}
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
 func sweepServiceLinkedRoles(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_kms_encrypt, name="Encrypt")
+func newEncryptEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &encryptEphemeralResource{}, nil
+}
+
+type encryptEphemeralResource struct {
+	framework.EphemeralResourceWithModel[encryptEphemeralResourceModel]
+}
+
+func (e *encryptEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ciphertext_blob": schema.StringAttribute{
+				Computed: true,
+			},
+			"context": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Optional:   true,
+			},
+			"encryption_algorithm": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EncryptionAlgorithmSpec](),
+				Optional:   true,
+			},
+			"grant_tokens": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Optional:   true,
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+			},
+			"plaintext": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *encryptEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data encryptEphemeralResourceModel
+	conn := e.Meta().KMSClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var input kms.EncryptInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.EncryptionContext = fwflex.ExpandFrameworkStringValueMap(ctx, data.Context)
+	input.Plaintext = []byte(data.Plaintext.ValueString())
+
+	keyID := data.KeyID.ValueString()
+	output, err := conn.Encrypt(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("encrypting with KMS Key (%s)", keyID), err.Error())
+		return
+	}
+
+	data.CiphertextBlob = types.StringValue(inttypes.Base64Encode(output.CiphertextBlob))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type encryptEphemeralResourceModel struct {
+	framework.WithRegionModel
+	CiphertextBlob      types.String                                         `tfsdk:"ciphertext_blob" autoflex:"-"`
+	Context             fwtypes.MapOfString                                  `tfsdk:"context" autoflex:"-"`
+	EncryptionAlgorithm fwtypes.StringEnum[awstypes.EncryptionAlgorithmSpec] `tfsdk:"encryption_algorithm"`
+	GrantTokens         fwtypes.ListOfString                                 `tfsdk:"grant_tokens"`
+	KeyID               types.String                                         `tfsdk:"key_id"`
+	Plaintext           types.String                                         `tfsdk:"plaintext" autoflex:"-"`
+}
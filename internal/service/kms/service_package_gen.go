@@ -24,6 +24,18 @@ type servicePackage struct{}
 
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
 	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newDataKeyEphemeralResource,
+			TypeName: "aws_kms_data_key",
+			Name:     "Data Key",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newEncryptEphemeralResource,
+			TypeName: "aws_kms_encrypt",
+			Name:     "Encrypt",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
 		{
 			Factory:  newSecretsEphemeralResource,
 			TypeName: "aws_kms_secrets",
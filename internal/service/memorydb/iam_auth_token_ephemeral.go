@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package memorydb
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/iamauth"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameIAMAuthToken = "Ephemeral Resource IAM Auth Token"
+
+	// iamAuthTokenSigningName is the SigV4 signing name of an IAM authentication token.
+	iamAuthTokenSigningName = "memorydb"
+)
+
+// @EphemeralResource(aws_memorydb_iam_auth_token, name="IAM Auth Token")
+func newIAMAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &iamAuthTokenEphemeralResource{}, nil
+}
+
+type iamAuthTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[iamAuthTokenEphemeralResourceModel]
+}
+
+func (e *iamAuthTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrClusterName: schema.StringAttribute{
+				Required: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *iamAuthTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data iamAuthTokenEphemeralResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	clusterName := data.ClusterName.ValueString()
+	token, err := buildIAMAuthToken(ctx, clusterName, data.UserName.ValueString(), e.Meta().Region(ctx), e.Meta().CredentialsProvider(ctx), time.Now())
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MemoryDB, create.ErrActionReading, ERNameIAMAuthToken, clusterName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// buildIAMAuthToken signs an IAM authentication token for the specified user and cluster.
+// See https://docs.aws.amazon.com/memorydb/latest/devguide/auth-iam.html.
+func buildIAMAuthToken(ctx context.Context, clusterName, userName, region string, credentialsProvider aws.CredentialsProvider, signingTime time.Time) (string, error) {
+	return iamauth.BuildToken(ctx, iamAuthTokenSigningName, clusterName, userName, region, credentialsProvider, signingTime, nil)
+}
+
+type iamAuthTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ClusterName types.String `tfsdk:"cluster_name"`
+	Token       types.String `tfsdk:"token"`
+	UserName    types.String `tfsdk:"user_name"`
+}
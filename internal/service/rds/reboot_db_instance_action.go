@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// rebootDBInstancePollInterval defines polling cadence for the reboot DB instance action.
+const rebootDBInstancePollInterval = 10 * time.Second
+
+// @Action(aws_rds_reboot_db_instance, name="Reboot DB Instance")
+func newRebootDBInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rebootDBInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*rebootDBInstanceAction)(nil)
+)
+
+type rebootDBInstanceAction struct {
+	framework.ActionWithModel[rebootDBInstanceModel]
+}
+
+type rebootDBInstanceModel struct {
+	framework.WithRegionModel
+	DBInstanceIdentifier types.String `tfsdk:"db_instance_identifier"`
+	ForceFailover        types.Bool   `tfsdk:"force_failover"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rebootDBInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reboots an RDS DB instance and waits for it to become available again.",
+		Attributes: map[string]schema.Attribute{
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB instance to reboot",
+				Required:    true,
+			},
+			"force_failover": schema.BoolAttribute{
+				Description: "Whether the reboot is conducted through a Multi-AZ failover. The DB instance must be configured for Multi-AZ",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the DB instance to become available (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *rebootDBInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rebootDBInstanceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, config.DBInstanceIdentifier)
+	forceFailover := fwflex.BoolValueFromFramework(ctx, config.ForceFailover)
+	timeout := fwactions.TimeoutOr(config.Timeout, 1800*time.Second)
+
+	tflog.Info(ctx, "Starting RDS reboot DB instance action", map[string]any{
+		"db_instance_identifier": id,
+		"force_failover":         forceFailover,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	if forceFailover {
+		cb(ctx, "Rebooting RDS DB instance %s with failover...", id)
+	} else {
+		cb(ctx, "Rebooting RDS DB instance %s...", id)
+	}
+
+	input := rds.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(id),
+	}
+	if forceFailover {
+		input.ForceFailover = aws.Bool(true)
+	}
+
+	_, err := conn.RebootDBInstance(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Reboot DB Instance",
+			fmt.Sprintf("Could not reboot RDS DB instance %s: %s", id, err),
+		)
+		return
+	}
+
+	cb(ctx, "Reboot of RDS DB instance %s started, waiting for it to become available...", id)
+
+	// The instance briefly reports "available" before the reboot begins,
+	// so require several consecutive observations of the target state.
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		output, err := findDBInstanceByID(ctx, conn, id)
+		if err != nil {
+			return actionwait.FetchResult[struct{}]{}, fmt.Errorf("describing DB instance: %w", err)
+		}
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(aws.ToString(output.DBInstanceStatus))}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(rebootDBInstancePollInterval),
+		ProgressInterval:   30 * time.Second,
+		ConsecutiveSuccess: 3,
+		SuccessStates: []actionwait.Status{
+			instanceStatusAvailable,
+			instanceStatusStorageOptimization,
+		},
+		TransitionalStates: []actionwait.Status{
+			instanceStatusBackingUp,
+			instanceStatusConfiguringEnhancedMonitoring,
+			instanceStatusConfiguringLogExports,
+			instanceStatusMaintenance,
+			instanceStatusModifying,
+			instanceStatusRebooting,
+		},
+		FailureStates: []actionwait.Status{
+			instanceStatusFailed,
+			instanceStatusInaccessibleEncryptionCredentials,
+			instanceStatusIncompatibleNetwork,
+			instanceStatusIncompatibleParameters,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "RDS DB instance %s is currently in state '%s', continuing to wait for 'available'...", id, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Instance Reboot",
+				fmt.Sprintf("RDS DB instance %s did not become available within %s: %s", id, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"DB Instance Reboot Failed",
+				fmt.Sprintf("RDS DB instance %s entered state '%s' while rebooting", id, failureErr.Status),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected DB Instance State",
+				fmt.Sprintf("RDS DB instance %s entered unexpected state while rebooting: %s", id, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Instance Reboot",
+				fmt.Sprintf("Error while waiting for RDS DB instance %s to become available: %s", id, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "RDS DB instance %s has been successfully rebooted", id)
+
+	tflog.Info(ctx, "RDS reboot DB instance action completed successfully", map[string]any{
+		"db_instance_identifier": id,
+	})
+}
@@ -62,6 +62,7 @@ var (
 	IsDirectoryBucket                           = isDirectoryBucket
 	ObjectListTags                              = objectListTags
 	ObjectUpdateTags                            = objectUpdateTags
+	PresignObjectURL                            = presignObjectURL
 	SDKv1CompatibleCleanKey                     = sdkv1CompatibleCleanKey
 	ValidBucketName                             = validBucketName
 
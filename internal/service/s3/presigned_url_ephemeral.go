@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	presignedURLDefaultExpiresIn = 15 * time.Minute
+	presignedURLMaxExpiresIn     = 7 * 24 * time.Hour
+)
+
+// @EphemeralResource(aws_s3_presigned_url, name="Presigned URL")
+func newPresignedURLEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &presignedURLEphemeralResource{}, nil
+}
+
+type presignedURLEphemeralResource struct {
+	framework.EphemeralResourceWithModel[presignedURLEphemeralResourceModel]
+}
+
+func (e *presignedURLEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, int64(presignedURLMaxExpiresIn/time.Second)),
+				},
+			},
+			names.AttrKey: schema.StringAttribute{
+				Required: true,
+			},
+			"method": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodPut),
+				},
+			},
+			"signed_headers": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Computed:   true,
+			},
+			names.AttrURL: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *presignedURLEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data presignedURLEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, key := data.Bucket.ValueString(), data.Key.ValueString()
+	conn := e.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = e.Meta().S3ExpressClient(ctx)
+	}
+
+	method := http.MethodGet
+	if !data.Method.IsNull() {
+		method = data.Method.ValueString()
+	}
+	expiresIn := presignedURLDefaultExpiresIn
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+
+	output, err := presignObjectURL(ctx, conn, method, bucket, key, expiresIn)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("presigning S3 Bucket (%s) Object (%s) %s URL", bucket, key, method), err.Error())
+		return
+	}
+
+	data.Method = types.StringValue(method)
+	data.ExpiresIn = types.Int64Value(int64(expiresIn / time.Second))
+	data.SignedHeaders = fwflex.FlattenFrameworkStringValueMapOfString(ctx, flattenSignedHeaders(output.SignedHeader))
+	data.URL = types.StringValue(output.URL)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// presignObjectURL returns a presigned request for the specified object and HTTP method.
+// Signing is done locally using conn's credentials, endpoint and addressing options.
+func presignObjectURL(ctx context.Context, conn *s3.Client, method, bucket, key string, expiresIn time.Duration) (*v4.PresignedHTTPRequest, error) {
+	client := s3.NewPresignClient(conn, s3.WithPresignExpires(expiresIn))
+
+	switch method {
+	case http.MethodDelete:
+		return client.PresignDeleteObject(ctx, &s3.DeleteObjectInput{Bucket: &bucket, Key: &key})
+	case http.MethodGet:
+		return client.PresignGetObject(ctx, &s3.GetObjectInput{Bucket: &bucket, Key: &key})
+	case http.MethodHead:
+		return client.PresignHeadObject(ctx, &s3.HeadObjectInput{Bucket: &bucket, Key: &key})
+	case http.MethodPut:
+		return client.PresignPutObject(ctx, &s3.PutObjectInput{Bucket: &bucket, Key: &key})
+	default:
+		return nil, fmt.Errorf("unsupported method: %s", method)
+	}
+}
+
+// flattenSignedHeaders returns the headers that must accompany the presigned request.
+// The Host header is implied by the URL and is omitted.
+func flattenSignedHeaders(header http.Header) map[string]string {
+	m := make(map[string]string)
+
+	for k, v := range header {
+		if http.CanonicalHeaderKey(k) == "Host" || len(v) == 0 {
+			continue
+		}
+		m[k] = v[0]
+	}
+
+	return m
+}
+
+type presignedURLEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Bucket        types.String        `tfsdk:"bucket"`
+	ExpiresIn     types.Int64         `tfsdk:"expires_in"`
+	Key           types.String        `tfsdk:"key"`
+	Method        types.String        `tfsdk:"method"`
+	SignedHeaders fwtypes.MapOfString `tfsdk:"signed_headers"`
+	URL           types.String        `tfsdk:"url"`
+}
@@ -5,22 +5,34 @@ package sfn
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// startExecutionPollInterval defines polling cadence for the start execution action
+// when waiting for the execution to complete.
+const startExecutionPollInterval = 10 * time.Second
+
 // @Action(aws_sfn_start_execution, name="Start Execution")
 func newStartExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
 	return &startExecutionAction{}, nil
@@ -36,10 +48,12 @@ type startExecutionAction struct {
 
 type startExecutionActionModel struct {
 	framework.WithRegionModel
-	StateMachineArn types.String `tfsdk:"state_machine_arn"`
-	Input           types.String `tfsdk:"input"`
-	Name            types.String `tfsdk:"name"`
-	TraceHeader     types.String `tfsdk:"trace_header"`
+	StateMachineArn   types.String `tfsdk:"state_machine_arn"`
+	Input             types.String `tfsdk:"input"`
+	Name              types.String `tfsdk:"name"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+	TraceHeader       types.String `tfsdk:"trace_header"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
 }
 
 func (a *startExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
@@ -61,10 +75,22 @@ func (a *startExecutionAction) Schema(ctx context.Context, req action.SchemaRequ
 				Description: "Name of the execution. Must be unique within the account/region/state machine for 90 days. Auto-generated if not provided.",
 				Optional:    true,
 			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the execution to complete when wait_for_completion is true (default: 3600).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(86400),
+				},
+			},
 			"trace_header": schema.StringAttribute{
 				Description: "AWS X-Ray trace header for distributed tracing.",
 				Optional:    true,
 			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Whether to wait for the execution to complete and fail if it does not succeed. Only supported for standard workflows. Defaults to false.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -122,4 +148,102 @@ func (a *startExecutionAction) Invoke(ctx context.Context, req action.InvokeRequ
 		"execution_arn":     executionArn,
 		"start_date":        output.StartDate,
 	})
+
+	if !config.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	timeout := fwactions.TimeoutOr(config.Timeout, 3600*time.Second)
+
+	cb(ctx, "Waiting for execution %s to complete...", executionArn)
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*sfn.DescribeExecutionOutput], error) {
+		output, err := findExecutionByARN(ctx, conn, executionArn)
+		if err != nil {
+			return actionwait.FetchResult[*sfn.DescribeExecutionOutput]{}, fmt.Errorf("describing execution: %w", err)
+		}
+		return actionwait.FetchResult[*sfn.DescribeExecutionOutput]{Status: actionwait.Status(output.Status), Value: output}, nil
+	}, actionwait.Options[*sfn.DescribeExecutionOutput]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(startExecutionPollInterval),
+		ProgressInterval:   time.Minute,
+		SuccessStates:      []actionwait.Status{actionwait.Status(awstypes.ExecutionStatusSucceeded)},
+		TransitionalStates: []actionwait.Status{actionwait.Status(awstypes.ExecutionStatusRunning)},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExecutionStatusAborted),
+			actionwait.Status(awstypes.ExecutionStatusFailed),
+			actionwait.Status(awstypes.ExecutionStatusPendingRedrive),
+			actionwait.Status(awstypes.ExecutionStatusTimedOut),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Execution %s is %s", executionArn, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Step Functions Execution",
+				fmt.Sprintf("Execution %s did not complete within %s: %s", executionArn, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			reason := string(failureErr.Status)
+			if result.Value != nil {
+				if v := aws.ToString(result.Value.Error); v != "" {
+					reason = fmt.Sprintf("%s: %s", reason, v)
+				}
+				if v := aws.ToString(result.Value.Cause); v != "" {
+					reason = fmt.Sprintf("%s (%s)", reason, v)
+				}
+			}
+			resp.Diagnostics.AddError(
+				"Step Functions Execution Failed",
+				fmt.Sprintf("Execution %s did not succeed: %s", executionArn, reason),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Step Functions Execution State",
+				fmt.Sprintf("Execution %s entered unexpected state: %s", executionArn, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Step Functions Execution",
+				fmt.Sprintf("Error while waiting for execution %s to complete: %s", executionArn, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Execution %s completed successfully", executionArn)
+
+	tflog.Info(ctx, "Step Functions execution completed successfully", map[string]any{
+		"state_machine_arn": stateMachineArn,
+		"execution_arn":     executionArn,
+	})
+}
+
+func findExecutionByARN(ctx context.Context, conn *sfn.Client, arn string) (*sfn.DescribeExecutionOutput, error) {
+	input := sfn.DescribeExecutionInput{
+		ExecutionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.ExecutionDoesNotExist](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
 }
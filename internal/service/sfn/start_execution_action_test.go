@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -89,6 +90,50 @@ func TestAccSFNStartExecutionAction_emptyInput(t *testing.T) {
 	})
 }
 
+func TestAccSFNStartExecutionAction_waitForCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartExecutionActionConfig_waitForCompletion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartExecutionActionStatus(ctx, t, rName, awstypes.ExecutionStatusSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStartExecutionAction_waitForCompletionFailed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartExecutionActionConfig_waitForCompletionFailed(rName),
+				ExpectError: regexache.MustCompile(`(?s)Step Functions Execution Failed.*TestError`),
+			},
+		},
+	})
+}
+
 // Test helper functions
 
 func testAccCheckStartExecutionAction(ctx context.Context, t *testing.T, stateMachineName, expectedInput string) resource.TestCheckFunc {
@@ -200,6 +245,47 @@ func testAccCheckStartExecutionActionWithName(ctx context.Context, t *testing.T,
 	}
 }
 
+func testAccCheckStartExecutionActionStatus(ctx context.Context, t *testing.T, stateMachineName string, expectedStatus awstypes.ExecutionStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).SFNClient(ctx)
+
+		stateMachines, err := conn.ListStateMachines(ctx, &sfn.ListStateMachinesInput{})
+		if err != nil {
+			return fmt.Errorf("failed to list state machines: %w", err)
+		}
+
+		var stateMachineArn string
+		for _, sm := range stateMachines.StateMachines {
+			if *sm.Name == stateMachineName {
+				stateMachineArn = *sm.StateMachineArn
+				break
+			}
+		}
+
+		if stateMachineArn == "" {
+			return fmt.Errorf("state machine %s not found", stateMachineName)
+		}
+
+		executions, err := conn.ListExecutions(ctx, &sfn.ListExecutionsInput{
+			StateMachineArn: &stateMachineArn,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list executions for state machine %s: %w", stateMachineName, err)
+		}
+
+		if len(executions.Executions) == 0 {
+			return fmt.Errorf("no executions found for state machine %s", stateMachineName)
+		}
+
+		// The action waits for completion, so the execution must already be finished.
+		if status := executions.Executions[0].Status; status != expectedStatus {
+			return fmt.Errorf("execution status mismatch. Expected: %s, Got: %s", expectedStatus, status)
+		}
+
+		return nil
+	}
+}
+
 // Configuration functions
 
 func testAccStartExecutionActionConfig_basic(rName, inputJSON string) string {
@@ -271,6 +357,91 @@ resource "terraform_data" "trigger" {
 `)
 }
 
+func testAccStartExecutionActionConfig_waitForCompletion(rName string) string {
+	return acctest.ConfigCompose(
+		testAccStartExecutionActionConfig_base(rName),
+		`
+action "aws_sfn_start_execution" "test" {
+  config {
+    state_machine_arn   = aws_sfn_state_machine.test.arn
+    wait_for_completion = true
+    timeout             = 300
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_sfn_start_execution.test]
+    }
+  }
+}
+`)
+}
+
+func testAccStartExecutionActionConfig_waitForCompletionFailed(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_service_principal" "states" {
+  service_name = "states"
+  region       = data.aws_region.current.name
+}
+
+resource "aws_iam_role" "for_sfn" {
+  name = "%[1]s-sfn"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = data.aws_service_principal.states.name
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+
+  definition = jsonencode({
+    Comment = "A state machine that always fails"
+    StartAt = "Fail"
+    States = {
+      Fail = {
+        Type  = "Fail"
+        Error = "TestError"
+        Cause = "Execution failed on purpose"
+      }
+    }
+  })
+}
+
+action "aws_sfn_start_execution" "test" {
+  config {
+    state_machine_arn   = aws_sfn_state_machine.test.arn
+    wait_for_completion = true
+    timeout             = 300
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_sfn_start_execution.test]
+    }
+  }
+}
+`, rName)
+}
+
 func testAccStartExecutionActionConfig_base(rName string) string {
 	return fmt.Sprintf(`
 data "aws_region" "current" {}
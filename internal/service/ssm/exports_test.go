@@ -18,6 +18,8 @@ var (
 	ResourceResourceDataSync        = resourceResourceDataSync
 	ResourceServiceSetting          = resourceServiceSetting
 
+	CommandInvocationProgress                          = commandInvocationProgress
+	FailedStepExecutionsDetail                         = failedStepExecutionsDetail
 	FindActivationByID                                 = findActivationByID
 	FindAssociationByID                                = findAssociationByID
 	FindDefaultPatchBaselineByOperatingSystem          = findDefaultPatchBaselineByOperatingSystem
@@ -31,4 +33,6 @@ var (
 	FindPatchGroupByTwoPartKey                         = findPatchGroupByTwoPartKey
 	FindResourceDataSyncByName                         = findResourceDataSyncByName
 	FindServiceSettingByID                             = findServiceSettingByID
+	StepExecutionProgress                              = stepExecutionProgress
+	TruncateOutput                                     = truncateOutput
 )
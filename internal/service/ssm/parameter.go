@@ -32,6 +32,7 @@ import (
 )
 
 // @SDKResource("aws_ssm_parameter", name="Parameter")
+// @AssumeRole
 // @Tags(identifierAttribute="id", resourceType="Parameter")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ssm/types;awstypes;awstypes.Parameter")
 // @Testing(importIgnore="has_value_wo")
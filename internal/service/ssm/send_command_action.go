@@ -0,0 +1,435 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// sendCommandPollInterval defines polling cadence for the send command action.
+	sendCommandPollInterval = 5 * time.Second
+
+	// progressOutputMaxLength is the maximum number of characters of command or step
+	// output included in a progress message.
+	progressOutputMaxLength = 500
+)
+
+// @Action(aws_ssm_send_command, name="Send Command")
+func newSendCommandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+var (
+	_ action.Action                     = (*sendCommandAction)(nil)
+	_ action.ActionWithConfigValidators = (*sendCommandAction)(nil)
+)
+
+type sendCommandAction struct {
+	framework.ActionWithModel[sendCommandModel]
+}
+
+type sendCommandModel struct {
+	framework.WithRegionModel
+	Comment         types.String                                 `tfsdk:"comment"`
+	DocumentName    types.String                                 `tfsdk:"document_name"`
+	DocumentVersion types.String                                 `tfsdk:"document_version"`
+	InstanceIDs     fwtypes.ListOfString                         `tfsdk:"instance_ids"`
+	MaxConcurrency  types.String                                 `tfsdk:"max_concurrency"`
+	MaxErrors       types.String                                 `tfsdk:"max_errors"`
+	Parameters      fwtypes.MapValueOf[fwtypes.ListOfString]     `tfsdk:"parameters"`
+	Targets         fwtypes.ListNestedObjectValueOf[targetModel] `tfsdk:"targets"`
+	Timeout         types.Int64                                  `tfsdk:"timeout"`
+}
+
+type targetModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an SSM command document on one or more managed nodes and waits for the command to finish on every target.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Description: "User-specified information about the command, such as a brief description of what the command should do",
+				Optional:    true,
+			},
+			"document_name": schema.StringAttribute{
+				Description: "The name, ARN or ID of the SSM document to run",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "The SSM document version to use. Defaults to the default version of the document",
+				Optional:    true,
+			},
+			"instance_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "The IDs of the managed nodes on which the command should run",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 50),
+				},
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "The maximum number or percentage of managed nodes that are allowed to run the command at the same time",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "The maximum number or percentage of errors allowed before the system stops sending the command to additional targets",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				CustomType:  fwtypes.NewMapTypeOf[fwtypes.ListOfString](ctx),
+				Description: "The parameters to pass to the SSM document",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the command to finish on all targets (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[targetModel](ctx),
+				Description: "Tag or resource group criteria identifying the managed nodes on which the command should run",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "The target key, for example tag:Environment or InstanceIds",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							Description: "The values for the target key",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *sendCommandAction) ConfigValidators(context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.ExactlyOneOf(
+			path.MatchRoot("instance_ids"),
+			path.MatchRoot("targets"),
+		),
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendCommandModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := fwflex.StringValueFromFramework(ctx, config.DocumentName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 3600*time.Second)
+
+	tflog.Info(ctx, "Starting SSM send command action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Sending SSM command %s...", documentName)
+
+	input := ssm.SendCommandInput{
+		Comment:         fwflex.StringFromFramework(ctx, config.Comment),
+		DocumentName:    aws.String(documentName),
+		DocumentVersion: fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		InstanceIds:     fwflex.ExpandFrameworkStringValueList(ctx, config.InstanceIDs),
+		MaxConcurrency:  fwflex.StringFromFramework(ctx, config.MaxConcurrency),
+		MaxErrors:       fwflex.StringFromFramework(ctx, config.MaxErrors),
+	}
+	if !config.Parameters.IsNull() {
+		resp.Diagnostics.Append(config.Parameters.ElementsAs(ctx, &input.Parameters, false)...)
+	}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.Targets, &input.Targets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.SendCommand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Send Command",
+			fmt.Sprintf("Could not send SSM command %s: %s", documentName, err),
+		)
+		return
+	}
+
+	id := aws.ToString(output.Command.CommandId)
+
+	cb(ctx, "SSM command %s sent, waiting for it to finish on all targets...", id)
+
+	// Per-target status changes are reported as soon as they are observed,
+	// while the overall command status is reported at the progress interval.
+	reported := make(map[string]string)
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[[]awstypes.CommandInvocation], error) {
+		command, err := findCommandByID(ctx, conn, id)
+		// The new command may not be visible immediately.
+		if retry.NotFound(err) {
+			return actionwait.FetchResult[[]awstypes.CommandInvocation]{Status: actionwait.Status(awstypes.CommandStatusPending)}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[[]awstypes.CommandInvocation]{}, fmt.Errorf("describing command: %w", err)
+		}
+
+		invocations, err := findCommandInvocationsByCommandID(ctx, conn, id)
+		if err != nil {
+			return actionwait.FetchResult[[]awstypes.CommandInvocation]{}, fmt.Errorf("listing command invocations: %w", err)
+		}
+
+		for _, message := range commandInvocationProgress(invocations, reported) {
+			cb(ctx, "%s", message)
+		}
+
+		return actionwait.FetchResult[[]awstypes.CommandInvocation]{Status: actionwait.Status(command.Status), Value: invocations}, nil
+	}, actionwait.Options[[]awstypes.CommandInvocation]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(sendCommandPollInterval),
+		ProgressInterval: time.Minute,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.CommandStatusSuccess)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusCancelling),
+			actionwait.Status(awstypes.CommandStatusInProgress),
+			actionwait.Status(awstypes.CommandStatusPending),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusCancelled),
+			actionwait.Status(awstypes.CommandStatusFailed),
+			actionwait.Status(awstypes.CommandStatusTimedOut),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "SSM command %s is %s", id, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Command",
+				fmt.Sprintf("SSM command %s did not finish within %s: %s", id, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Command Failed",
+				fmt.Sprintf("SSM command %s did not succeed: %s%s", id, failureErr.Status, failedCommandInvocationsDetail(result.Value)),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Command State",
+				fmt.Sprintf("SSM command %s entered unexpected state: %s", id, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Command",
+				fmt.Sprintf("Error while waiting for SSM command %s: %s", id, err),
+			)
+		}
+		return
+	}
+
+	// A command can succeed overall while individual targets fail within the
+	// configured error threshold. Any failed target fails the action.
+	if detail := failedCommandInvocationsDetail(result.Value); detail != "" {
+		resp.Diagnostics.AddError(
+			"Command Failed",
+			fmt.Sprintf("SSM command %s did not succeed on all targets%s", id, detail),
+		)
+		return
+	}
+
+	cb(ctx, "SSM command %s completed successfully on %d targets", id, len(result.Value))
+
+	tflog.Info(ctx, "SSM send command action completed successfully", map[string]any{
+		"command_id":    id,
+		"document_name": documentName,
+	})
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	return findCommand(ctx, conn, &input)
+}
+
+func findCommand(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) (*awstypes.Command, error) {
+	output, err := findCommands(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCommands(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) ([]awstypes.Command, error) {
+	var output []awstypes.Command
+
+	pages := ssm.NewListCommandsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidCommandId](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Commands...)
+	}
+
+	return output, nil
+}
+
+func findCommandInvocationsByCommandID(ctx context.Context, conn *ssm.Client, id string) ([]awstypes.CommandInvocation, error) {
+	input := ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+		Details:   true,
+	}
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
+
+// commandInvocationStatus returns the status of a command invocation, qualified
+// by its status details when they add information (e.g. "Failed (Undeliverable)").
+func commandInvocationStatus(invocation awstypes.CommandInvocation) string {
+	status := string(invocation.Status)
+	if details := aws.ToString(invocation.StatusDetails); details != "" && details != status {
+		status = fmt.Sprintf("%s (%s)", status, details)
+	}
+	return status
+}
+
+// commandInvocationOutput returns the combined standard output of all plugins of a command invocation.
+func commandInvocationOutput(invocation awstypes.CommandInvocation) string {
+	var outputs []string
+	for _, plugin := range invocation.CommandPlugins {
+		if output := strings.TrimSpace(aws.ToString(plugin.Output)); output != "" {
+			outputs = append(outputs, output)
+		}
+	}
+	return strings.Join(outputs, "\n")
+}
+
+// commandInvocationProgress returns a progress message for each command invocation whose status has
+// changed since it was last reported, and records the new statuses in reported.
+// Messages for finished invocations include their truncated output.
+func commandInvocationProgress(invocations []awstypes.CommandInvocation, reported map[string]string) []string {
+	var messages []string
+
+	for _, invocation := range invocations {
+		target := aws.ToString(invocation.InstanceId)
+		status := commandInvocationStatus(invocation)
+		if reported[target] == status {
+			continue
+		}
+		reported[target] = status
+
+		message := fmt.Sprintf("Target %s is %s", target, status)
+		switch invocation.Status {
+		case awstypes.CommandInvocationStatusPending, awstypes.CommandInvocationStatusInProgress, awstypes.CommandInvocationStatusDelayed, awstypes.CommandInvocationStatusCancelling:
+		default:
+			if output := commandInvocationOutput(invocation); output != "" {
+				message = fmt.Sprintf("%s, output:\n%s", message, truncateOutput(output, progressOutputMaxLength))
+			}
+		}
+		messages = append(messages, message)
+	}
+
+	return messages
+}
+
+// failedCommandInvocationsDetail describes the command invocations that did not succeed.
+// An empty string is returned if all invocations succeeded.
+func failedCommandInvocationsDetail(invocations []awstypes.CommandInvocation) string {
+	var failed []string
+	for _, invocation := range invocations {
+		if invocation.Status == awstypes.CommandInvocationStatusSuccess {
+			continue
+		}
+		detail := fmt.Sprintf("%s: %s", aws.ToString(invocation.InstanceId), commandInvocationStatus(invocation))
+		if output := commandInvocationOutput(invocation); output != "" {
+			detail = fmt.Sprintf("%s, output:\n%s", detail, truncateOutput(output, progressOutputMaxLength))
+		}
+		failed = append(failed, detail)
+	}
+
+	if len(failed) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("\n\nFailed targets:\n%s", strings.Join(failed, "\n"))
+}
+
+// truncateOutput shortens s to at most n characters, marking truncated output with an ellipsis.
+func truncateOutput(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n]) + "..."
+}
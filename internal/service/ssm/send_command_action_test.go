@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTruncateOutput(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		s    string
+		n    int
+		want string
+	}{
+		"short": {
+			s:    "hello",
+			n:    10,
+			want: "hello",
+		},
+		"exact": {
+			s:    "hello",
+			n:    5,
+			want: "hello",
+		},
+		"truncated": {
+			s:    "hello world",
+			n:    5,
+			want: "hello...",
+		},
+		"multibyte": {
+			s:    "héllo wörld",
+			n:    7,
+			want: "héllo w...",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfssm.TruncateOutput(testCase.s, testCase.n), testCase.want; got != want {
+				t.Errorf("TruncateOutput() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestCommandInvocationProgress(t *testing.T) {
+	t.Parallel()
+
+	reported := make(map[string]string)
+
+	// First poll: both targets are reported.
+	got := tfssm.CommandInvocationProgress([]awstypes.CommandInvocation{
+		{InstanceId: aws.String("i-1"), Status: awstypes.CommandInvocationStatusInProgress, StatusDetails: aws.String("InProgress")},
+		{InstanceId: aws.String("i-2"), Status: awstypes.CommandInvocationStatusPending, StatusDetails: aws.String("Pending")},
+	}, reported)
+	want := []string{
+		"Target i-1 is InProgress",
+		"Target i-2 is Pending",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Second poll: only the target whose status changed is reported, with its output.
+	got = tfssm.CommandInvocationProgress([]awstypes.CommandInvocation{
+		{
+			InstanceId:    aws.String("i-1"),
+			Status:        awstypes.CommandInvocationStatusSuccess,
+			StatusDetails: aws.String("Success"),
+			CommandPlugins: []awstypes.CommandPlugin{
+				{Name: aws.String("aws:runShellScript"), Output: aws.String("hello\n" + strings.Repeat("x", 600))},
+			},
+		},
+		{InstanceId: aws.String("i-2"), Status: awstypes.CommandInvocationStatusPending, StatusDetails: aws.String("Pending")},
+	}, reported)
+	want = []string{
+		"Target i-1 is Success, output:\nhello\n" + strings.Repeat("x", 494) + "...",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Third poll: status details that differ from the status are included.
+	got = tfssm.CommandInvocationProgress([]awstypes.CommandInvocation{
+		{InstanceId: aws.String("i-1"), Status: awstypes.CommandInvocationStatusSuccess, StatusDetails: aws.String("Success")},
+		{InstanceId: aws.String("i-2"), Status: awstypes.CommandInvocationStatusFailed, StatusDetails: aws.String("Undeliverable")},
+	}, reported)
+	want = []string{
+		"Target i-2 is Failed (Undeliverable)",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAccSSMSendCommandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	registrationSleep := func() resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+			time.Sleep(1 * time.Minute)
+			return nil
+		}
+	}
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check:  registrationSleep(),
+			},
+			{
+				Config: testAccSendCommandActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandActionSucceeded(ctx, t, "aws_instance.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSendCommandActionSucceeded(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).SSMClient(ctx)
+
+		input := ssm.ListCommandInvocationsInput{
+			InstanceId: aws.String(rs.Primary.ID),
+		}
+		output, err := conn.ListCommandInvocations(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		if len(output.CommandInvocations) == 0 {
+			return fmt.Errorf("SSM managed node (%s) has no command invocations", rs.Primary.ID)
+		}
+
+		if got, want := output.CommandInvocations[0].Status, awstypes.CommandInvocationStatusSuccess; got != want {
+			return fmt.Errorf("SSM managed node (%s) command invocation status = %s, want %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccSendCommandActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), `
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    comment       = "Terraform acceptance test"
+
+    targets {
+      key    = "InstanceIds"
+      values = [aws_instance.test.id]
+    }
+
+    parameters = {
+      commands = ["echo hello"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`)
+}
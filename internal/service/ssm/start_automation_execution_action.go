@@ -0,0 +1,362 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startAutomationExecutionPollInterval defines polling cadence for the start automation execution action.
+const startAutomationExecutionPollInterval = 10 * time.Second
+
+// @Action(aws_ssm_start_automation_execution, name="Start Automation Execution")
+func newStartAutomationExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startAutomationExecutionAction{}, nil
+}
+
+var (
+	_ action.Action                     = (*startAutomationExecutionAction)(nil)
+	_ action.ActionWithConfigValidators = (*startAutomationExecutionAction)(nil)
+)
+
+type startAutomationExecutionAction struct {
+	framework.ActionWithModel[startAutomationExecutionModel]
+}
+
+type startAutomationExecutionModel struct {
+	framework.WithRegionModel
+	DocumentName        types.String                                 `tfsdk:"document_name"`
+	DocumentVersion     types.String                                 `tfsdk:"document_version"`
+	MaxConcurrency      types.String                                 `tfsdk:"max_concurrency"`
+	MaxErrors           types.String                                 `tfsdk:"max_errors"`
+	Parameters          fwtypes.MapValueOf[fwtypes.ListOfString]     `tfsdk:"parameters"`
+	TargetParameterName types.String                                 `tfsdk:"target_parameter_name"`
+	Targets             fwtypes.ListNestedObjectValueOf[targetModel] `tfsdk:"targets"`
+	Timeout             types.Int64                                  `tfsdk:"timeout"`
+}
+
+func (a *startAutomationExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an SSM Automation runbook execution and waits for the execution to finish.",
+		Attributes: map[string]schema.Attribute{
+			"document_name": schema.StringAttribute{
+				Description: "The name or ARN of the SSM Automation runbook to run",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "The version of the Automation runbook to use. Defaults to the default version of the runbook",
+				Optional:    true,
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "The maximum number or percentage of targets that are allowed to run the runbook at the same time",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "The maximum number or percentage of errors allowed before the system stops running the runbook on additional targets",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				CustomType:  fwtypes.NewMapTypeOf[fwtypes.ListOfString](ctx),
+				Description: "The input parameters of the Automation runbook",
+				Optional:    true,
+			},
+			"target_parameter_name": schema.StringAttribute{
+				Description: "The name of the runbook parameter used as the target resource for a rate-controlled execution",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the automation execution to finish (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[targetModel](ctx),
+				Description: "Tag, resource group or parameter value criteria identifying the resources on which the runbook should run",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "The target key, for example tag:Environment or ParameterValues",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							Description: "The values for the target key",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startAutomationExecutionAction) ConfigValidators(context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.RequiredTogether(
+			path.MatchRoot("target_parameter_name"),
+			path.MatchRoot("targets"),
+		),
+	}
+}
+
+func (a *startAutomationExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startAutomationExecutionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := fwflex.StringValueFromFramework(ctx, config.DocumentName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 3600*time.Second)
+
+	tflog.Info(ctx, "Starting SSM start automation execution action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting SSM Automation runbook %s...", documentName)
+
+	input := ssm.StartAutomationExecutionInput{
+		DocumentName:        aws.String(documentName),
+		DocumentVersion:     fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		MaxConcurrency:      fwflex.StringFromFramework(ctx, config.MaxConcurrency),
+		MaxErrors:           fwflex.StringFromFramework(ctx, config.MaxErrors),
+		TargetParameterName: fwflex.StringFromFramework(ctx, config.TargetParameterName),
+	}
+	if !config.Parameters.IsNull() {
+		resp.Diagnostics.Append(config.Parameters.ElementsAs(ctx, &input.Parameters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.Targets, &input.Targets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.StartAutomationExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Automation Execution",
+			fmt.Sprintf("Could not start SSM Automation runbook %s: %s", documentName, err),
+		)
+		return
+	}
+
+	id := aws.ToString(output.AutomationExecutionId)
+
+	cb(ctx, "Automation execution %s started, waiting for it to finish...", id)
+
+	// Step status changes are reported as soon as they are observed,
+	// while the overall execution status is reported at the progress interval.
+	reported := make(map[string]string)
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.AutomationExecution], error) {
+		output, err := findAutomationExecutionByID(ctx, conn, id)
+		// The new execution may not be visible immediately.
+		if retry.NotFound(err) {
+			return actionwait.FetchResult[*awstypes.AutomationExecution]{Status: actionwait.Status(awstypes.AutomationExecutionStatusPending)}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.AutomationExecution]{}, fmt.Errorf("describing automation execution: %w", err)
+		}
+
+		for _, message := range stepExecutionProgress(output.StepExecutions, reported) {
+			cb(ctx, "%s", message)
+		}
+
+		return actionwait.FetchResult[*awstypes.AutomationExecution]{Status: actionwait.Status(output.AutomationExecutionStatus), Value: output}, nil
+	}, actionwait.Options[*awstypes.AutomationExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startAutomationExecutionPollInterval),
+		ProgressInterval: time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithSuccess),
+			actionwait.Status(awstypes.AutomationExecutionStatusSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusApproved),
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelling),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideApproved),
+			actionwait.Status(awstypes.AutomationExecutionStatusInprogress),
+			actionwait.Status(awstypes.AutomationExecutionStatusPending),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingApproval),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingChangeCalendarOverride),
+			actionwait.Status(awstypes.AutomationExecutionStatusRunbookInprogress),
+			actionwait.Status(awstypes.AutomationExecutionStatusScheduled),
+			actionwait.Status(awstypes.AutomationExecutionStatusWaiting),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelled),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithFailure),
+			actionwait.Status(awstypes.AutomationExecutionStatusExited),
+			actionwait.Status(awstypes.AutomationExecutionStatusFailed),
+			actionwait.Status(awstypes.AutomationExecutionStatusRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusTimedout),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if execution, ok := fr.Value.(*awstypes.AutomationExecution); ok && execution != nil && execution.CurrentStepName != nil {
+				cb(ctx, "Automation execution %s is %s (current step: %s)", id, fr.Status, aws.ToString(execution.CurrentStepName))
+			} else {
+				cb(ctx, "Automation execution %s is %s", id, fr.Status)
+			}
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Automation Execution",
+				fmt.Sprintf("SSM automation execution %s did not finish within %s: %s", id, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			reason := string(failureErr.Status)
+			if result.Value != nil && result.Value.FailureMessage != nil {
+				reason = fmt.Sprintf("%s: %s", reason, aws.ToString(result.Value.FailureMessage))
+			}
+			resp.Diagnostics.AddError(
+				"Automation Execution Failed",
+				fmt.Sprintf("SSM automation execution %s did not succeed: %s", id, reason),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Automation Execution State",
+				fmt.Sprintf("SSM automation execution %s entered unexpected state: %s", id, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Automation Execution",
+				fmt.Sprintf("Error while waiting for SSM automation execution %s: %s", id, err),
+			)
+		}
+		return
+	}
+
+	// An execution can succeed overall while steps that allow it to continue
+	// on failure fail. Any failed step fails the action.
+	if detail := failedStepExecutionsDetail(result.Value.StepExecutions); detail != "" {
+		resp.Diagnostics.AddError(
+			"Automation Execution Failed",
+			fmt.Sprintf("SSM automation execution %s did not succeed on all steps%s", id, detail),
+		)
+		return
+	}
+
+	cb(ctx, "Automation execution %s completed successfully", id)
+
+	tflog.Info(ctx, "SSM start automation execution action completed successfully", map[string]any{
+		"automation_execution_id": id,
+		"document_name":           documentName,
+	})
+}
+
+func findAutomationExecutionByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.AutomationExecution, error) {
+	input := ssm.GetAutomationExecutionInput{
+		AutomationExecutionId: aws.String(id),
+	}
+	output, err := conn.GetAutomationExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.AutomationExecutionNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AutomationExecution == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.AutomationExecution, nil
+}
+
+// stepExecutionProgress returns a progress message for each automation step whose status has
+// changed since it was last reported, and records the new statuses in reported.
+// Messages for failed steps include their truncated failure message.
+func stepExecutionProgress(steps []awstypes.StepExecution, reported map[string]string) []string {
+	var messages []string
+
+	for _, step := range steps {
+		key := aws.ToString(step.StepExecutionId)
+		status := string(step.StepStatus)
+		if reported[key] == status {
+			continue
+		}
+		reported[key] = status
+
+		message := fmt.Sprintf("Step %s is %s", aws.ToString(step.StepName), status)
+		if failure := aws.ToString(step.FailureMessage); failure != "" {
+			message = fmt.Sprintf("%s: %s", message, truncateOutput(failure, progressOutputMaxLength))
+		}
+		messages = append(messages, message)
+	}
+
+	return messages
+}
+
+// failedStepExecutionsDetail returns a description of the failed or timed out automation steps, or "" if there are none.
+func failedStepExecutionsDetail(steps []awstypes.StepExecution) string {
+	var failed []string
+	for _, step := range steps {
+		switch step.StepStatus {
+		case awstypes.AutomationExecutionStatusFailed, awstypes.AutomationExecutionStatusTimedout:
+		default:
+			continue
+		}
+		detail := fmt.Sprintf("%s: %s", aws.ToString(step.StepName), step.StepStatus)
+		if failure := aws.ToString(step.FailureMessage); failure != "" {
+			detail = fmt.Sprintf("%s: %s", detail, truncateOutput(failure, progressOutputMaxLength))
+		}
+		failed = append(failed, detail)
+	}
+
+	if len(failed) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("\n\nFailed steps:\n%s", strings.Join(failed, "\n"))
+}
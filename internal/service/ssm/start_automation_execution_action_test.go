@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestStepExecutionProgress(t *testing.T) {
+	t.Parallel()
+
+	reported := make(map[string]string)
+
+	got := tfssm.StepExecutionProgress([]awstypes.StepExecution{
+		{StepExecutionId: aws.String("1"), StepName: aws.String("sleep"), StepStatus: awstypes.AutomationExecutionStatusSuccess},
+		{StepExecutionId: aws.String("2"), StepName: aws.String("check"), StepStatus: awstypes.AutomationExecutionStatusInprogress},
+	}, reported)
+	want := []string{
+		"Step sleep is Success",
+		"Step check is InProgress",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	got = tfssm.StepExecutionProgress([]awstypes.StepExecution{
+		{StepExecutionId: aws.String("1"), StepName: aws.String("sleep"), StepStatus: awstypes.AutomationExecutionStatusSuccess},
+		{StepExecutionId: aws.String("2"), StepName: aws.String("check"), StepStatus: awstypes.AutomationExecutionStatusFailed, FailureMessage: aws.String("Step timed out")},
+	}, reported)
+	want = []string{
+		"Step check is Failed: Step timed out",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFailedStepExecutionsDetail(t *testing.T) {
+	t.Parallel()
+
+	if got := tfssm.FailedStepExecutionsDetail([]awstypes.StepExecution{
+		{StepName: aws.String("sleep"), StepStatus: awstypes.AutomationExecutionStatusSuccess},
+	}); got != "" {
+		t.Errorf("unexpected detail for successful steps: %q", got)
+	}
+
+	got := tfssm.FailedStepExecutionsDetail([]awstypes.StepExecution{
+		{StepName: aws.String("sleep"), StepStatus: awstypes.AutomationExecutionStatusSuccess},
+		{StepName: aws.String("check"), StepStatus: awstypes.AutomationExecutionStatusFailed, FailureMessage: aws.String("Assertion failed")},
+		{StepName: aws.String("wait"), StepStatus: awstypes.AutomationExecutionStatusTimedout},
+	})
+	want := "\n\nFailed steps:\ncheck: Failed: Assertion failed\nwait: TimedOut"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestAccSSMStartAutomationExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDocumentDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartAutomationExecutionActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartAutomationExecutionActionSucceeded(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStartAutomationExecutionActionSucceeded(ctx context.Context, t *testing.T, documentName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).SSMClient(ctx)
+
+		input := ssm.DescribeAutomationExecutionsInput{
+			Filters: []awstypes.AutomationExecutionFilter{
+				{
+					Key:    awstypes.AutomationExecutionFilterKeyDocumentNamePrefix,
+					Values: []string{documentName},
+				},
+			},
+		}
+		output, err := conn.DescribeAutomationExecutions(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		if len(output.AutomationExecutionMetadataList) == 0 {
+			return fmt.Errorf("SSM Document (%s) has no automation executions", documentName)
+		}
+
+		if got, want := output.AutomationExecutionMetadataList[0].AutomationExecutionStatus, awstypes.AutomationExecutionStatusSuccess; got != want {
+			return fmt.Errorf("SSM Document (%s) automation execution status = %s, want %s", documentName, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartAutomationExecutionActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name            = %[1]q
+  document_type   = "Automation"
+  document_format = "YAML"
+
+  content = <<DOC
+schemaVersion: '0.3'
+description: Terraform acceptance test
+parameters:
+  Duration:
+    type: String
+    default: PT1S
+mainSteps:
+  - name: sleep
+    action: aws:sleep
+    inputs:
+      Duration: '{{ Duration }}'
+DOC
+}
+
+action "aws_ssm_start_automation_execution" "test" {
+  config {
+    document_name = aws_ssm_document.test.name
+
+    parameters = {
+      Duration = ["PT5S"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_ssm_start_automation_execution.test]
+    }
+  }
+}
+`, rName)
+}
@@ -8,6 +8,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var (
+	regionKey       = inttypes.NewContextKey[string]()
+	resourceTypeKey = inttypes.NewContextKey[string]()
 )
 
 func Context(region string) context.Context {
@@ -17,5 +23,16 @@ func Context(region string) context.Context {
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = regionKey.NewContext(ctx, region)
+
+	return ctx
+}
+
+// WithResourceType returns ctx annotated with the resource type being swept.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = log.WithResourceType(ctx, resourceType)
+
+	ctx = resourceTypeKey.NewContext(ctx, resourceType)
+
 	return ctx
 }
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Describable is implemented by Sweepables that can identify the resource they delete.
+// It is used for dry runs, filtering and reporting.
+type Describable interface {
+	Sweepable
+
+	// Definition returns the resource's definition: its SDK schema.Resource or its Framework factory function.
+	Definition() any
+	// ID returns the resource's ID.
+	ID() string
+	// Name returns the resource's name, if known.
+	Name() string
+	// Tags returns the resource's tags, if known.
+	Tags() map[string]string
+}
+
+// Description identifies a resource to be swept.
+type Description struct {
+	Type   string            `json:"type"`
+	ID     string            `json:"id"`
+	Name   string            `json:"name,omitempty"`
+	Region string            `json:"region"`
+	Tags   map[string]string `json:"-"`
+}
+
+func describe(ctx context.Context, sweepable Sweepable) Description {
+	d := Description{
+		Type:   resourceTypeKey.FromContext(ctx),
+		Region: regionKey.FromContext(ctx),
+	}
+
+	if v, ok := sweepable.(Describable); ok {
+		d.ID = v.ID()
+		d.Name = v.Name()
+		d.Tags = v.Tags()
+		if d.Type == "" {
+			d.Type = resourceTypes()[definitionKey(v.Definition())]
+		}
+	}
+
+	return d
+}
+
+// resourceTypes returns the resource type names implemented by ServicePackages,
+// keyed by the code pointer of each SDK resource's Delete function and of each Framework resource's factory function.
+// Sweepers are not registered with a resource type, so this is the only way to find the type of a Sweepable's resource.
+var resourceTypes = sync.OnceValue(func() map[uintptr]string {
+	ctx := context.Background()
+	m := make(map[uintptr]string)
+
+	for _, sp := range ServicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			if key := definitionKey(v.Factory()); key != 0 {
+				m[key] = v.TypeName
+			}
+		}
+		for _, v := range sp.FrameworkResources(ctx) {
+			m[definitionKey(v.Factory)] = v.TypeName
+		}
+	}
+
+	return m
+})
+
+func definitionKey(definition any) uintptr {
+	var fn any
+
+	switch v := definition.(type) {
+	case *schema.Resource:
+		switch {
+		case v.DeleteWithoutTimeout != nil:
+			fn = v.DeleteWithoutTimeout
+		case v.DeleteContext != nil:
+			fn = v.DeleteContext
+		case v.Delete != nil: //nolint:staticcheck // Legacy resources.
+			fn = v.Delete //nolint:staticcheck // Legacy resources.
+		}
+	default:
+		fn = v
+	}
+
+	if v := reflect.ValueOf(fn); v.Kind() == reflect.Func && !v.IsNil() {
+		return v.Pointer()
+	}
+
+	return 0
+}
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// Filter determines which of the resources listed by sweepers are deleted.
+// Deny filters take precedence over allow filters.
+// When any allow filter is set, only resources matching an allow filter are deleted.
+type Filter struct {
+	AllowNamePrefixes []string
+	DenyNamePrefixes  []string
+	AllowTags         []TagFilter
+	DenyTags          []TagFilter
+}
+
+// TagFilter matches resources with the tag Key and, if HasValue is true, the tag value Value.
+type TagFilter struct {
+	Key      string
+	Value    string
+	HasValue bool
+}
+
+// IsZero reports whether the filter matches all resources.
+func (f Filter) IsZero() bool {
+	return len(f.AllowNamePrefixes) == 0 && len(f.DenyNamePrefixes) == 0 && len(f.AllowTags) == 0 && len(f.DenyTags) == 0
+}
+
+// SkipReason returns why the described resource must not be swept, or "" if it may be.
+//
+// Name prefixes are matched against the resource's name or, if its name is unknown, its ID.
+// A resource whose tags are unknown never matches an allow tag filter and,
+// because it may carry a denied tag, is always skipped when any deny tag filter is set.
+func (f Filter) SkipReason(d Description) string {
+	name := d.Name
+	if name == "" {
+		name = d.ID
+	}
+
+	if v, ok := matchNamePrefix(name, f.DenyNamePrefixes); ok {
+		return fmt.Sprintf("name matches denied prefix %q", v)
+	}
+	if len(f.DenyTags) > 0 && d.Tags == nil {
+		return "tags unknown and deny tag filter set"
+	}
+	if v, ok := matchTag(d.Tags, f.DenyTags); ok {
+		return fmt.Sprintf("tags match denied tag %q", v)
+	}
+
+	if len(f.AllowNamePrefixes) == 0 && len(f.AllowTags) == 0 {
+		return ""
+	}
+	if _, ok := matchNamePrefix(name, f.AllowNamePrefixes); ok {
+		return ""
+	}
+	if _, ok := matchTag(d.Tags, f.AllowTags); ok {
+		return ""
+	}
+
+	return "no allowed name prefix or tag matches"
+}
+
+func (t TagFilter) String() string {
+	if t.HasValue {
+		return t.Key + "=" + t.Value
+	}
+
+	return t.Key
+}
+
+func matchNamePrefix(name string, prefixes []string) (string, bool) {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return prefix, true
+		}
+	}
+
+	return "", false
+}
+
+func matchTag(tags map[string]string, filters []TagFilter) (TagFilter, bool) {
+	for _, filter := range filters {
+		if v, ok := tags[filter.Key]; ok && (!filter.HasValue || v == filter.Value) {
+			return filter, true
+		}
+	}
+
+	return TagFilter{}, false
+}
+
+type options struct {
+	dependencyOrdered bool
+	dryRun            bool
+	filter            Filter
+	regionParallelism int
+	reportFile        string
+}
+
+// sweepOptions returns the sweeper options configured via environment variables.
+var sweepOptions = sync.OnceValues(func() (options, error) {
+	opts := options{
+		filter: Filter{
+			AllowNamePrefixes: splitList(os.Getenv(envvar.SweepAllowNamePrefixes)),
+			DenyNamePrefixes:  splitList(os.Getenv(envvar.SweepDenyNamePrefixes)),
+			AllowTags:         parseTagFilters(os.Getenv(envvar.SweepAllowTags)),
+			DenyTags:          parseTagFilters(os.Getenv(envvar.SweepDenyTags)),
+		},
+		regionParallelism: 1,
+		reportFile:        os.Getenv(envvar.SweepReportFile),
+	}
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		opts.dryRun = b
+	}
+
+	if v := os.Getenv(envvar.SweepDependencyOrdered); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", envvar.SweepDependencyOrdered, err)
+		}
+		opts.dependencyOrdered = b
+	}
+
+	if v := os.Getenv(envvar.SweepRegionParallelism); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", envvar.SweepRegionParallelism, err)
+		}
+		if n < 1 {
+			return opts, fmt.Errorf("environment variable %s: must be at least 1", envvar.SweepRegionParallelism)
+		}
+		opts.regionParallelism = n
+	}
+
+	return opts, nil
+})
+
+func splitList(s string) []string {
+	var l []string
+
+	for v := range strings.SplitSeq(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			l = append(l, v)
+		}
+	}
+
+	return l
+}
+
+func parseTagFilters(s string) []TagFilter {
+	var filters []TagFilter
+
+	for _, v := range splitList(s) {
+		key, value, hasValue := strings.Cut(v, "=")
+		filters = append(filters, TagFilter{
+			Key:      key,
+			Value:    value,
+			HasValue: hasValue,
+		})
+	}
+
+	return filters
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func TestFilterSkipReason(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		filter   sweep.Filter
+		resource sweep.Description
+		wantSkip bool
+	}{
+		"no filter": {
+			resource: sweep.Description{ID: "i-12345678"},
+		},
+		"allowed name prefix": {
+			filter:   sweep.Filter{AllowNamePrefixes: []string{"tf-acc-test-"}},
+			resource: sweep.Description{ID: "id", Name: "tf-acc-test-123"},
+		},
+		"allowed name prefix matches ID": {
+			filter:   sweep.Filter{AllowNamePrefixes: []string{"tf-acc-test-"}},
+			resource: sweep.Description{ID: "tf-acc-test-123"},
+		},
+		"no allowed name prefix": {
+			filter:   sweep.Filter{AllowNamePrefixes: []string{"tf-acc-test-"}},
+			resource: sweep.Description{ID: "id", Name: "production"},
+			wantSkip: true,
+		},
+		"denied name prefix": {
+			filter:   sweep.Filter{AllowNamePrefixes: []string{"tf-"}, DenyNamePrefixes: []string{"tf-keep-"}},
+			resource: sweep.Description{ID: "id", Name: "tf-keep-123"},
+			wantSkip: true,
+		},
+		"allowed tag key": {
+			filter:   sweep.Filter{AllowTags: []sweep.TagFilter{{Key: "Purpose"}}},
+			resource: sweep.Description{ID: "id", Tags: map[string]string{"Purpose": "test"}},
+		},
+		"allowed tag value mismatch": {
+			filter:   sweep.Filter{AllowTags: []sweep.TagFilter{{Key: "Purpose", Value: "acctest", HasValue: true}}},
+			resource: sweep.Description{ID: "id", Tags: map[string]string{"Purpose": "test"}},
+			wantSkip: true,
+		},
+		"allowed tag unknown tags": {
+			filter:   sweep.Filter{AllowTags: []sweep.TagFilter{{Key: "Purpose"}}},
+			resource: sweep.Description{ID: "id"},
+			wantSkip: true,
+		},
+		"denied tag": {
+			filter:   sweep.Filter{DenyTags: []sweep.TagFilter{{Key: "DoNotDelete"}}},
+			resource: sweep.Description{ID: "id", Tags: map[string]string{"DoNotDelete": ""}},
+			wantSkip: true,
+		},
+		"denied tag unknown tags": {
+			filter:   sweep.Filter{DenyTags: []sweep.TagFilter{{Key: "DoNotDelete"}}},
+			resource: sweep.Description{ID: "id"},
+			wantSkip: true,
+		},
+		"denied tag not present": {
+			filter:   sweep.Filter{DenyTags: []sweep.TagFilter{{Key: "DoNotDelete"}}},
+			resource: sweep.Description{ID: "id", Tags: map[string]string{"Purpose": "test"}},
+		},
+		"allowed name prefix or tag": {
+			filter:   sweep.Filter{AllowNamePrefixes: []string{"tf-acc-test-"}, AllowTags: []sweep.TagFilter{{Key: "Purpose"}}},
+			resource: sweep.Description{ID: "id", Name: "example", Tags: map[string]string{"Purpose": "test"}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.filter.SkipReason(testCase.resource); (got != "") != testCase.wantSkip {
+				t.Errorf("SkipReason() = %q, want skip %t", got, testCase.wantSkip)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -111,6 +112,70 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return err
 }
 
+// Definition returns the resource's factory function.
+func (sr *sweepResource) Definition() any {
+	return sr.factory
+}
+
+// ID returns the resource's ID: the value of its "id" attribute or, if not set, of its first attribute.
+func (sr *sweepResource) ID() string {
+	if v, ok := sr.attribute(names.AttrID); ok {
+		return v
+	}
+
+	if len(sr.attributes) > 0 {
+		return attributeString(sr.attributes[0].value)
+	}
+
+	return ""
+}
+
+// Name returns the resource's name, if set by the sweeper.
+func (sr *sweepResource) Name() string {
+	v, _ := sr.attribute(names.AttrName)
+
+	return v
+}
+
+// Tags returns the resource's tags, if set by the sweeper.
+func (sr *sweepResource) Tags() map[string]string {
+	for _, attr := range sr.attributes {
+		if attr.path != names.AttrTags {
+			continue
+		}
+
+		switch v := attr.value.(type) {
+		case map[string]string:
+			return v
+		case map[string]*string:
+			return aws.ToStringMap(v)
+		}
+	}
+
+	return nil
+}
+
+func (sr *sweepResource) attribute(path string) (string, bool) {
+	for _, attr := range sr.attributes {
+		if attr.path == path {
+			return attributeString(attr.value), true
+		}
+	}
+
+	return "", false
+}
+
+func attributeString(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case *string:
+		return aws.ToString(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -0,0 +1,447 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Dependency-ordered sweeping.
+//
+// Rather than each sweeper deleting its resources as soon as they are listed, all sweepers for a Region first list
+// their resources, which become the nodes of a dependency graph. A resource depends on every resource listed by the
+// sweepers its sweeper declares as dependencies, and on the resources listed by earlier SweepOrchestrator calls from
+// its own sweeper. Resources are then deleted in waves: each wave deletes, concurrently, all resources with no remaining
+// dependencies. A resource whose deletion fails because another resource depends on it (e.g. a DependencyViolation
+// error) is made to depend on the other remaining resources and retried in a later wave.
+
+// maxSweepAttempts is the number of times deletion of a resource is attempted in a dependency-ordered sweep.
+const maxSweepAttempts = 5
+
+// sweepWaveDelay is the delay before a wave that retries failed deletions, allowing time for asynchronous
+// deletion of dependent resources (e.g. Lambda function ENIs) to complete.
+var sweepWaveDelay = 30 * time.Second
+
+// dependencyViolationErrorCodes are AWS API error codes returned when a resource cannot be deleted because
+// another resource depends on it.
+var dependencyViolationErrorCodes = []string{
+	"DeleteConflict",
+	"DependencyViolation",
+	"InvalidGroup.InUse",
+	"ResourceInUse",
+	"ResourceInUseException",
+}
+
+func isDependencyViolationError(err error) bool {
+	// Errors are typically returned as diagnostics, so match on the error message.
+	return slices.ContainsFunc(dependencyViolationErrorCodes, func(code string) bool {
+		return errs.Contains(err, code)
+	})
+}
+
+type sweepNode struct {
+	ctx         context.Context //nolint:containedctx // The context of the sweeper that listed the resource.
+	sweepable   Sweepable
+	optFns      []tfresource.OptionsFunc
+	description Description
+	attempts    int
+	err         error
+}
+
+// sweepPlan collects the resources listed by a Region's sweepers and deletes them in dependency order.
+type sweepPlan struct {
+	mu       sync.Mutex
+	region   string
+	sweeper  string         // The sweeper currently listing resources.
+	calls    map[string]int // SweepOrchestrator calls, by sweeper.
+	graph    *depgraph.Graph
+	nodes    map[string]*sweepNode // Resource nodes.
+	barriers map[string]struct{}   // Sweeper and SweepOrchestrator call nodes.
+}
+
+func newSweepPlan(region string) *sweepPlan {
+	return &sweepPlan{
+		region:   region,
+		calls:    make(map[string]int),
+		graph:    depgraph.New(),
+		nodes:    make(map[string]*sweepNode),
+		barriers: make(map[string]struct{}),
+	}
+}
+
+// Graph node keys.
+// Sweeper nodes depend on all of a sweeper's resources, and call nodes on all of the resources listed by one
+// SweepOrchestrator call, so that dependencies on them can be expressed by a single edge and hold even when
+// no resources are listed.
+func sweeperNodeKey(sweeper string) string {
+	return "sweeper:" + sweeper
+}
+
+func callNodeKey(sweeper string, call int) string {
+	return "call:" + sweeper + ":" + strconv.Itoa(call)
+}
+
+func resourceNodeKey(sweeper string, call, i int) string {
+	return "resource:" + sweeper + ":" + strconv.Itoa(call) + ":" + strconv.Itoa(i)
+}
+
+var (
+	sweepPlansLock sync.Mutex
+	// sweepPlans holds the plan of each Region being listed for dependency-ordered sweeping.
+	sweepPlans = make(map[string]*sweepPlan)
+)
+
+func sweepPlanFor(ctx context.Context) *sweepPlan {
+	sweepPlansLock.Lock()
+	defer sweepPlansLock.Unlock()
+
+	return sweepPlans[regionKey.FromContext(ctx)]
+}
+
+func (p *sweepPlan) addBarrier(key string) {
+	p.graph.AddNode(key)
+	p.barriers[key] = struct{}{}
+}
+
+// dependencyKeys returns the keys of the nodes of the specified sweeper's declared dependencies.
+func (p *sweepPlan) dependencyKeys(sweeper string) []string {
+	s, ok := sweepers[sweeper]
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(s.Dependencies))
+	for _, dep := range s.Dependencies {
+		key := sweeperNodeKey(dep)
+		p.addBarrier(key)
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// add adds the resources listed by a SweepOrchestrator call to the plan.
+func (p *sweepPlan) add(ctx context.Context, sweepables []Sweepable, opts options, optFns []tfresource.OptionsFunc) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sweeper := p.sweeper
+	call := p.calls[sweeper]
+	p.calls[sweeper]++
+
+	// A call's resources are deleted after the resources of the sweeper's dependencies
+	// and of the sweeper's previous calls.
+	dependencies := p.dependencyKeys(sweeper)
+	callKey := callNodeKey(sweeper, call)
+	p.addBarrier(callKey)
+	for _, key := range dependencies {
+		if err := p.graph.AddDependency(callKey, key); err != nil {
+			return err
+		}
+	}
+	if call > 0 {
+		dependencies = []string{callNodeKey(sweeper, call-1)}
+	}
+
+	for i, sweepable := range sweepables {
+		d := describe(ctx, sweepable)
+		if d.Type == "" {
+			d.Type = sweeper
+		}
+
+		if reason := opts.filter.SkipReason(d); reason != "" {
+			tflog.Info(ctx, "Skipping resource", map[string]any{
+				"sweep_resource_id": d.ID,
+				"reason":            reason,
+			})
+			recordResult(d, StatusSkipped, reason)
+			continue
+		}
+
+		key := resourceNodeKey(sweeper, call, i)
+		p.nodes[key] = &sweepNode{
+			ctx:         tflog.SetField(ctx, "sweep_resource_id", d.ID),
+			sweepable:   sweepable,
+			optFns:      optFns,
+			description: d,
+		}
+		p.graph.AddNode(key)
+
+		if err := p.graph.AddDependency(callKey, key); err != nil {
+			return err
+		}
+		for _, dependency := range dependencies {
+			if err := p.graph.AddDependency(key, dependency); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// list runs the specified sweepers, adding the resources they list to the plan.
+func (p *sweepPlan) list(selected map[string]*resource.Sweeper) error {
+	var errs []error
+
+	for _, name := range slices.Sorted(maps.Keys(selected)) {
+		p.mu.Lock()
+		p.sweeper = name
+		p.addBarrier(sweeperNodeKey(name))
+		p.mu.Unlock()
+
+		if err := selected[name].F(p.region); err != nil {
+			errs = append(errs, fmt.Errorf("sweeper (%s) for region (%s) failed: %w", name, p.region, err))
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for name := range selected {
+		key := sweeperNodeKey(name)
+
+		for call := range p.calls[name] {
+			if err := p.graph.AddDependency(key, callNodeKey(name, call)); err != nil {
+				return err
+			}
+		}
+		for _, dependency := range p.dependencyKeys(name) {
+			if err := p.graph.AddDependency(key, dependency); err != nil {
+				return err
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweep deletes the plan's resources in dependency order.
+func (p *sweepPlan) sweep(ctx context.Context, opts options) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.graph.OverallOrder(); err != nil {
+		return fmt.Errorf("ordering resources for region (%s): %w", p.region, err)
+	}
+
+	var errs []error
+
+	for wave := 1; ; wave++ {
+		ready := p.ready()
+		if len(ready) == 0 {
+			break
+		}
+
+		retry := slices.ContainsFunc(ready, func(key string) bool {
+			return p.nodes[key].attempts > 0
+		})
+		if retry && !opts.dryRun {
+			p.mu.Unlock()
+			time.Sleep(sweepWaveDelay)
+			p.mu.Lock()
+		}
+
+		tflog.Info(ctx, "Sweeping resources", map[string]any{
+			"sweep_wave":      wave,
+			"sweep_resources": len(ready),
+		})
+
+		var wg sync.WaitGroup
+		for _, key := range ready {
+			node := p.nodes[key]
+			node.attempts++
+			node.err = nil
+
+			if opts.dryRun {
+				tflog.Info(node.ctx, "Would sweep resource", map[string]any{
+					"sweep_wave":          wave,
+					"sweep_resource_type": node.description.Type,
+					"sweep_resource_name": node.description.Name,
+				})
+				continue
+			}
+
+			wg.Go(func() {
+				node.err = node.sweepable.Delete(node.ctx, node.optFns...)
+			})
+		}
+		wg.Wait()
+
+		for _, key := range ready {
+			node := p.nodes[key]
+
+			switch {
+			case opts.dryRun:
+				recordResult(node.description, StatusWouldDelete, "")
+				p.remove(key)
+
+			case node.err == nil:
+				recordResult(node.description, StatusDeleted, "")
+				p.remove(key)
+
+			case node.attempts >= maxSweepAttempts:
+				recordResult(node.description, StatusFailed, node.err.Error())
+				errs = append(errs, node.err)
+				p.remove(key)
+
+			case isDependencyViolationError(node.err):
+				tflog.Info(node.ctx, "Resource has dependents, retrying after other resources", map[string]any{
+					"error": node.err.Error(),
+				})
+				if err := p.deferNode(key); err != nil {
+					return err
+				}
+
+			default:
+				tflog.Warn(node.ctx, "Sweeping resource failed, retrying", map[string]any{
+					"error": node.err.Error(),
+				})
+			}
+		}
+	}
+
+	// Any remaining resources are part of a dependency cycle between sweepers.
+	for key, node := range p.nodes {
+		recordResult(node.description, StatusFailed, "unresolvable dependencies")
+		errs = append(errs, fmt.Errorf("sweeping %s (%s): unresolvable dependencies", node.description.Type, node.description.ID))
+		p.remove(key)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ready returns the keys of the resources with no remaining dependencies,
+// first removing sweeper and call nodes with no remaining dependencies.
+func (p *sweepPlan) ready() []string {
+	for removed := true; removed; {
+		removed = false
+
+		for key := range p.barriers {
+			if v, _ := p.graph.DirectDependenciesOf(key); len(v) == 0 {
+				p.graph.RemoveNode(key)
+				delete(p.barriers, key)
+				removed = true
+			}
+		}
+	}
+
+	var ready []string
+
+	for key := range p.nodes {
+		if v, _ := p.graph.DirectDependenciesOf(key); len(v) == 0 {
+			ready = append(ready, key)
+		}
+	}
+	slices.Sort(ready)
+
+	return ready
+}
+
+// deferNode makes the specified resource depend on all other remaining resources that do not depend on it.
+func (p *sweepPlan) deferNode(key string) error {
+	dependents, err := p.graph.DependentsOf(key)
+	if err != nil {
+		return err
+	}
+
+	for other := range p.nodes {
+		if other == key || slices.Contains(dependents, other) {
+			continue
+		}
+		if p.nodes[other].err != nil && isDependencyViolationError(p.nodes[other].err) {
+			// Avoid resources that are themselves waiting on dependents.
+			continue
+		}
+
+		if err := p.graph.AddDependency(key, other); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *sweepPlan) remove(key string) {
+	p.graph.RemoveNode(key)
+	delete(p.nodes, key)
+}
+
+// sweepRegionWithGraph sweeps the specified Region in dependency order.
+func sweepRegionWithGraph(region string, selected map[string]*resource.Sweeper, opts options) error {
+	ctx := Context(region)
+
+	p := newSweepPlan(region)
+
+	sweepPlansLock.Lock()
+	sweepPlans[region] = p
+	sweepPlansLock.Unlock()
+
+	tflog.Info(ctx, "Listing resources", map[string]any{
+		"sweepers": len(selected),
+	})
+	errList := p.list(selected)
+
+	sweepPlansLock.Lock()
+	delete(sweepPlans, region)
+	sweepPlansLock.Unlock()
+
+	errSweep := p.sweep(ctx, opts)
+
+	return errors.Join(errList, errSweep, writeReport(opts))
+}
+
+// selectedSweepers returns the sweepers selected by the -sweep-run flag, and their dependencies.
+func selectedSweepers() map[string]*resource.Sweeper {
+	var names []string
+	if f := flag.Lookup("sweep-run"); f != nil {
+		names = splitList(strings.ToLower(f.Value.String()))
+	}
+
+	if len(names) == 0 {
+		return maps.Clone(sweepers)
+	}
+
+	result := make(map[string]*resource.Sweeper)
+
+	var add func(string)
+	add = func(name string) {
+		if _, ok := result[name]; ok {
+			return
+		}
+		s, ok := sweepers[name]
+		if !ok {
+			return
+		}
+		result[name] = s
+		for _, dep := range s.Dependencies {
+			add(dep)
+		}
+	}
+
+	for name := range sweepers {
+		for _, v := range names {
+			if strings.Contains(strings.ToLower(name), v) {
+				add(name)
+			}
+		}
+	}
+
+	return result
+}
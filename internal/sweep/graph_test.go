@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	id      string
+	deleted *[]string
+	mu      *sync.Mutex
+	// deleteErr returns the error to be returned by Delete, if any.
+	deleteErr func() error
+}
+
+func (s testSweepable) Delete(context.Context, ...tfresource.OptionsFunc) error {
+	if s.deleteErr != nil {
+		if err := s.deleteErr(); err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	*s.deleted = append(*s.deleted, s.id)
+
+	return nil
+}
+
+func (s testSweepable) Definition() any         { return nil }
+func (s testSweepable) ID() string              { return s.id }
+func (s testSweepable) Name() string            { return "" }
+func (s testSweepable) Tags() map[string]string { return nil }
+
+func TestSweepRegionWithGraph(t *testing.T) { //nolint:paralleltest // Uses the package-level sweeper registry.
+	const region = "us-west-2" // lintignore:AWSAT003
+
+	var (
+		mu      sync.Mutex
+		deleted []string
+	)
+	newSweepable := func(id string, deleteErr func() error) Sweepable {
+		return testSweepable{id: id, deleted: &deleted, mu: &mu, deleteErr: deleteErr}
+	}
+	isDeleted := func(id string) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Contains(deleted, id)
+	}
+
+	// No sweeper dependency declares that the security group can't be deleted while the network interface exists.
+	listers := map[string][]Sweepable{
+		"aws_instance":          {newSweepable("i-1", nil)},
+		"aws_network_interface": {newSweepable("eni-1", nil)},
+		"aws_security_group": {newSweepable("sg-1", func() error {
+			if !isDeleted("eni-1") {
+				return errors.New("DependencyViolation: resource sg-1 has a dependent object")
+			}
+			return nil
+		})},
+		"aws_subnet": {newSweepable("subnet-1", nil), newSweepable("subnet-2", nil)},
+		"aws_vpc":    {newSweepable("vpc-1", nil)},
+	}
+	dependencies := map[string][]string{
+		"aws_network_interface": {"aws_instance"},
+		"aws_subnet":            {"aws_network_interface"},
+		"aws_vpc":               {"aws_security_group", "aws_subnet"},
+	}
+
+	selected := make(map[string]*resource.Sweeper)
+	for name, sweepables := range listers {
+		selected[name] = &resource.Sweeper{
+			Name: name,
+			F: func(region string) error {
+				return SweepOrchestrator(WithResourceType(Context(region), name), sweepables)
+			},
+			Dependencies: dependencies[name],
+		}
+	}
+
+	// A sweeper that deletes its resources directly is skipped.
+	selected["aws_route_table"] = &resource.Sweeper{
+		Name: "aws_route_table",
+		F: func(region string) error {
+			if skip, err := SkipDirectSweeper(Context(region), "aws_route_table"); skip || err != nil {
+				return err
+			}
+			return newSweepable("rtb-1", nil).Delete(t.Context())
+		},
+	}
+
+	savedSweepers, savedDelay := sweepers, sweepWaveDelay
+	t.Cleanup(func() {
+		sweepers, sweepWaveDelay = savedSweepers, savedDelay
+	})
+	sweepers, sweepWaveDelay = selected, 0
+
+	if err := sweepRegionWithGraph(region, selected, options{}); err != nil {
+		t.Fatalf("sweepRegionWithGraph: %s", err)
+	}
+
+	if got, want := len(deleted), 6; got != want {
+		t.Fatalf("deleted %d resources, want %d: %v", got, want, deleted)
+	}
+	if isDeleted("rtb-1") {
+		t.Errorf("direct sweeper deleted rtb-1: %v", deleted)
+	}
+
+	for _, v := range [][2]string{
+		{"i-1", "eni-1"},
+		{"eni-1", "subnet-1"},
+		{"eni-1", "subnet-2"},
+		{"eni-1", "sg-1"},
+		{"subnet-1", "vpc-1"},
+		{"subnet-2", "vpc-1"},
+		{"sg-1", "vpc-1"},
+	} {
+		if slices.Index(deleted, v[0]) > slices.Index(deleted, v[1]) {
+			t.Errorf("%s deleted after %s: %v", v[0], v[1], deleted)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+// TestMain runs the tests or, when the -sweep flag is set, the registered sweepers.
+// Sweepers are run in dependency order when so configured (see sweepRegionWithGraph).
+//
+// When sweeping more than one Region and the configured Region parallelism is greater than 1,
+// each Region is swept by a separate process running this test binary, so that Regions are swept concurrently
+// with no state shared between them. The processes' reports are merged into the configured report file.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	opts, err := sweepOptions()
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	regions := sweepRegions()
+
+	if len(regions) > 1 && opts.regionParallelism > 1 {
+		if err := sweepRegionsConcurrently(context.Background(), regions, opts); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(regions) > 0 && opts.dependencyOrdered {
+		if err := sweepRegionsWithGraph(regions, opts); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	resource.TestMain(m)
+}
+
+// sweepRegionsWithGraph sweeps the specified Regions, one at a time, in dependency order.
+func sweepRegionsWithGraph(regions []string, opts options) error {
+	selected := selectedSweepers()
+	allowFailures := false
+	if f := flag.Lookup("sweep-allow-failures"); f != nil {
+		allowFailures, _ = strconv.ParseBool(f.Value.String())
+	}
+
+	var errs []error
+
+	for _, region := range regions {
+		log.Printf("[DEBUG] Running Sweepers for region (%s) in dependency order", region)
+
+		if err := sweepRegionWithGraph(region, selected, opts); err != nil {
+			if !allowFailures {
+				return err
+			}
+			log.Printf("[ERROR] Error running Sweepers in region (%s): %s", region, err)
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+const sweepFlagName = "sweep"
+
+// sweepRegions returns the Regions specified by the -sweep flag.
+func sweepRegions() []string {
+	f := flag.Lookup(sweepFlagName)
+	if f == nil {
+		return nil
+	}
+
+	return splitList(f.Value.String())
+}
+
+func sweepRegionsConcurrently(ctx context.Context, regions []string, opts options) error {
+	dir, err := os.MkdirTemp("", "sweep")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	var g tfsync.Group
+	sem := make(chan struct{}, opts.regionParallelism)
+
+	for _, region := range regions {
+		g.Go(ctx, func(ctx context.Context) error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			log.Printf("[DEBUG] Running Sweepers for region (%s) in a separate process", region)
+
+			cmd := exec.CommandContext(ctx, os.Args[0], regionArgs(os.Args[1:], region)...) // #nosec G204 -- Re-executes this test binary.
+			cmd.Env = append(os.Environ(),
+				envvar.SweepRegionParallelism+"=1",
+				envvar.SweepReportFile+"="+regionReportFile(dir, region),
+			)
+			cmd.Stdout = os.Stdout
+			cmd.Stderr = os.Stderr
+
+			if err := cmd.Run(); err != nil {
+				return fmt.Errorf("sweeping region (%s): %w", region, err)
+			}
+
+			return nil
+		})
+	}
+
+	err = g.Wait(ctx)
+
+	if opts.reportFile == "" {
+		return err
+	}
+
+	var results []Result
+	for _, region := range regions {
+		r, errRead := ReadReport(regionReportFile(dir, region))
+		if errors.Is(errRead, os.ErrNotExist) {
+			// No resources were swept.
+			continue
+		}
+		if errRead != nil {
+			err = errors.Join(err, errRead)
+			continue
+		}
+		results = append(results, r.Resources...)
+	}
+
+	if errWrite := NewReport(opts.dryRun, results).WriteFile(opts.reportFile); errWrite != nil {
+		err = errors.Join(err, fmt.Errorf("writing sweeper report (%s): %w", opts.reportFile, errWrite))
+	}
+
+	return err
+}
+
+func regionReportFile(dir, region string) string {
+	return filepath.Join(dir, region+".json")
+}
+
+// regionArgs returns the command line arguments args with the -sweep flag's value replaced by region.
+func regionArgs(args []string, region string) []string {
+	var result []string
+
+	for i := 0; i < len(args); i++ {
+		name, _, hasValue := strings.Cut(strings.TrimLeft(args[i], "-"), "=")
+		if !strings.HasPrefix(args[i], "-") || name != sweepFlagName {
+			result = append(result, args[i])
+			continue
+		}
+		if !hasValue {
+			i++ // Skip the flag's value.
+		}
+	}
+
+	return slices.Concat(result, []string{"-" + sweepFlagName + "=" + region})
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+)
+
+// Status is the outcome of sweeping a resource.
+type Status string
+
+const (
+	StatusDeleted     Status = "deleted"
+	StatusFailed      Status = "failed"
+	StatusSkipped     Status = "skipped"
+	StatusWouldDelete Status = "would_delete" // Dry run.
+)
+
+// Result is the outcome of sweeping a resource.
+type Result struct {
+	Description
+	Status Status `json:"status"`
+	Reason string `json:"reason,omitempty"` // Why the resource was skipped or failed to delete.
+}
+
+// Report summarizes a sweeper run.
+type Report struct {
+	DryRun    bool           `json:"dry_run"`
+	Summary   map[Status]int `json:"summary"`
+	Resources []Result       `json:"resources"`
+}
+
+// NewReport returns a Report of the specified results.
+func NewReport(dryRun bool, results []Result) *Report {
+	r := &Report{
+		DryRun:    dryRun,
+		Summary:   make(map[Status]int),
+		Resources: slices.Clone(results),
+	}
+
+	for _, v := range results {
+		r.Summary[v.Status]++
+	}
+	slices.SortStableFunc(r.Resources, func(a, b Result) int {
+		return cmp.Or(
+			cmp.Compare(a.Region, b.Region),
+			cmp.Compare(a.Type, b.Type),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+
+	return r
+}
+
+// ReadReport reads a JSON report from the specified file.
+func ReadReport(path string) (*Report, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var r Report
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, fmt.Errorf("reading sweeper report (%s): %w", path, err)
+	}
+
+	return &r, nil
+}
+
+// WriteFile writes the report as JSON to the specified file, replacing any existing report.
+func (r *Report) WriteFile(path string) error {
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write then rename so that a concurrent reader never sees a partial report.
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// results accumulates the outcome of every resource swept by this process.
+var results struct {
+	sync.Mutex
+	l []Result
+}
+
+func recordResult(d Description, status Status, reason string) {
+	results.Lock()
+	defer results.Unlock()
+
+	results.l = append(results.l, Result{
+		Description: d,
+		Status:      status,
+		Reason:      reason,
+	})
+}
+
+// writeReport writes the results accumulated so far to the configured report file, if any.
+// The report is rewritten after each sweeper so that it is complete even if a later sweeper fails the run.
+func writeReport(opts options) error {
+	if opts.reportFile == "" {
+		return nil
+	}
+
+	results.Lock()
+	defer results.Unlock()
+
+	r := NewReport(opts.dryRun, results.l)
+	if err := r.WriteFile(opts.reportFile); err != nil {
+		return fmt.Errorf("writing sweeper report (%s): %w", opts.reportFile, err)
+	}
+
+	return nil
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -39,6 +41,41 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+// Definition returns the resource's schema.Resource.
+func (sr *sweepResource) Definition() any {
+	return sr.resource
+}
+
+// ID returns the resource's ID.
+func (sr *sweepResource) ID() string {
+	return sr.d.Id()
+}
+
+// Name returns the resource's name, if set by the sweeper.
+func (sr *sweepResource) Name() string {
+	if _, ok := sr.resource.SchemaMap()[names.AttrName]; !ok {
+		return ""
+	}
+
+	v, _ := sr.d.Get(names.AttrName).(string)
+
+	return v
+}
+
+// Tags returns the resource's tags, if set by the sweeper.
+func (sr *sweepResource) Tags() map[string]string {
+	if _, ok := sr.resource.SchemaMap()[names.AttrTags]; !ok {
+		return nil
+	}
+
+	v, ok := sr.d.Get(names.AttrTags).(map[string]any)
+	if !ok || len(v) == 0 {
+		return nil
+	}
+
+	return flex.ExpandStringValueMap(v)
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
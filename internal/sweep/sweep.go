@@ -5,13 +5,16 @@ package sweep
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
@@ -27,12 +30,19 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // ServicePackages is set in TestMain in order to break an import cycle.
 var ServicePackages []conns.ServicePackage
 
-// sweeperClients is a shared cache of regional conns.AWSClient
-// This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+var (
+	sweeperClientsLock sync.Mutex
+	// sweeperClients is a shared cache of regional conns.AWSClient
+	// This prevents client re-initialization for every resource with no benefit.
+	sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
+// It is safe for concurrent use.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -104,20 +114,113 @@ type Sweepable interface {
 	Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator deletes the specified resources concurrently.
+//
+// Resources excluded by the configured filter are skipped, and in a dry run no resources are deleted.
+// In a dependency-ordered sweep, the resources are instead added to the Region's dependency graph.
+// The outcome for each resource is added to the sweeper report, if one is configured.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	opts, err := sweepOptions()
+	if err != nil {
+		return err
+	}
+
+	// In a dependency-ordered sweep, resources are deleted once all sweepers have listed their resources.
+	if p := sweepPlanFor(ctx); p != nil {
+		return p.add(ctx, sweepables, opts, optFns)
+	}
+
+	// Only describe resources when the description is used.
+	describing := opts.dryRun || opts.reportFile != "" || !opts.filter.IsZero()
+
 	var g tfsync.Group
 
 	for _, sweepable := range sweepables {
+		if !describing {
+			g.Go(ctx, func(ctx context.Context) error {
+				return sweepable.Delete(ctx, optFns...)
+			})
+			continue
+		}
+
+		d := describe(ctx, sweepable)
+		ctx := tflog.SetField(ctx, "sweep_resource_id", d.ID)
+
+		if reason := opts.filter.SkipReason(d); reason != "" {
+			tflog.Info(ctx, "Skipping resource", map[string]any{
+				"reason": reason,
+			})
+			recordResult(d, StatusSkipped, reason)
+			continue
+		}
+
+		if opts.dryRun {
+			tflog.Info(ctx, "Would sweep resource", map[string]any{
+				"sweep_resource_type": d.Type,
+				"sweep_resource_name": d.Name,
+			})
+			recordResult(d, StatusWouldDelete, "")
+			continue
+		}
+
 		g.Go(ctx, func(ctx context.Context) error {
-			return sweepable.Delete(ctx, optFns...)
+			err := sweepable.Delete(ctx, optFns...)
+
+			if err != nil {
+				recordResult(d, StatusFailed, err.Error())
+			} else {
+				recordResult(d, StatusDeleted, "")
+			}
+
+			return err
 		})
 	}
 
-	return g.Wait(ctx)
+	err = g.Wait(ctx)
+
+	return errors.Join(err, writeReport(opts))
+}
+
+// SkipDirectSweeper reports whether a sweeper that deletes resources itself, rather than via SweepOrchestrator, must not run.
+// Such a sweeper can't honor a dry run, filter or dependency ordering, so when any is configured the sweeper is skipped
+// and recorded as skipped in the sweeper report.
+func SkipDirectSweeper(ctx context.Context, resourceType string) (bool, error) {
+	opts, err := sweepOptions()
+	if err != nil {
+		return false, err
+	}
+
+	if !opts.dryRun && opts.filter.IsZero() && sweepPlanFor(ctx) == nil {
+		return false, nil
+	}
+
+	const reason = "sweeper deletes resources directly and does not support dry run, filters or dependency ordering"
+	tflog.Info(ctx, "Skipping sweeper", map[string]any{
+		"sweep_resource_type": resourceType,
+		"reason":              reason,
+	})
+	recordResult(Description{
+		Type:   resourceType,
+		Region: regionKey.FromContext(ctx),
+	}, StatusSkipped, reason)
+
+	return true, writeReport(opts)
 }
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
+
+// sweepers holds all registered sweepers, by name.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper with the acceptance testing framework.
+// Sweepers must be registered via this function, rather than resource.AddTestSweepers, so that their
+// declared dependencies are available for dependency-ordered sweeping.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweepers[name] = s
+
+	resource.AddTestSweepers(name, s)
+}
@@ -12,6 +12,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"text/template"
 
 	"github.com/hashicorp/go-cty/cty"
 	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
@@ -53,6 +54,12 @@ const (
 	// Valid values are "error", "warning", and "disabled". Any other value will trigger an error
 	// during provider initialization.
 	TagPolicyComplianceEnvVar = "TF_AWS_TAG_POLICY_COMPLIANCE"
+
+	// Environment variable specifying the path of a local tag policy document
+	//
+	// When set, tag policy compliance is evaluated against the document rather than the
+	// organization's effective tag policy.
+	TagPolicyFileEnvVar = "TF_AWS_TAG_POLICY_FILE"
 )
 
 // DefaultConfig contains tags to default across all resources.
@@ -75,9 +82,27 @@ type TagPolicyConfig struct {
 	// shared across both Plugin SDK V2 and Plugin Framework based resources.
 	Severity string
 
+	// PolicyFile is the path of a local tag policy document
+	//
+	// When set, required tags and tag rules are read from the document rather
+	// than the ListRequiredTags API.
+	PolicyFile string
+
 	// RequiredTags is a mapping of Terraform resource type names to the required
 	// tags defined in the effective tag policy
 	RequiredTags map[string]KeyValueTags
+
+	// Rules is a mapping of Terraform resource type names to the tag key
+	// capitalization and value constraints enforced by the tag policy
+	//
+	// Rules are only available from a local tag policy document.
+	Rules map[string][]TagPolicyRule
+
+	// RemediationTags is a mapping of required tag keys to the templates used to
+	// derive values for those keys when they are missing from a resource's tags
+	//
+	// Remediated tags are treated as additional default tags for the resource.
+	RemediationTags map[string]*template.Template
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// RemediationData is the data available to tag policy remediation templates.
+type RemediationData struct {
+	AccountID    string
+	Partition    string
+	Region       string
+	ResourceType string
+}
+
+// remediationFuncs are the functions available to tag policy remediation templates.
+// Arguments are ordered so that the functions can be used in pipelines.
+var remediationFuncs = template.FuncMap{
+	"lower": strings.ToLower,
+	"replace": func(old, new, s string) string {
+		return strings.ReplaceAll(s, old, new)
+	},
+	"trimPrefix": func(prefix, s string) string {
+		return strings.TrimPrefix(s, prefix)
+	},
+	"upper": strings.ToUpper,
+}
+
+// NewRemediationTags parses the tag policy remediation value templates in m, keyed by tag key.
+// Templates use Go text/template syntax, with RemediationData as data.
+func NewRemediationTags(m map[string]string) (map[string]*template.Template, error) {
+	result := make(map[string]*template.Template, len(m))
+
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		t, err := template.New(k).Funcs(remediationFuncs).Option("missingkey=error").Parse(m[k])
+		if err != nil {
+			return nil, fmt.Errorf("parsing value for tag %q: %w", k, err)
+		}
+
+		// Catch references to unknown fields before any resource is planned.
+		if err := t.Execute(&strings.Builder{}, RemediationData{}); err != nil {
+			return nil, fmt.Errorf("parsing value for tag %q: %w", k, err)
+		}
+
+		result[k] = t
+	}
+
+	return result, nil
+}
+
+// RemediatedDefaultConfig returns the default tags configuration to use for resources of type typeName.
+// Tags required by the tag policy for the resource type that are missing from defaultConfig and have a remediation template
+// are added to the returned configuration's tags. The added tags are also returned.
+func (c *TagPolicyConfig) RemediatedDefaultConfig(defaultConfig *DefaultConfig, typeName string, data func() RemediationData) (*DefaultConfig, KeyValueTags, error) {
+	if c == nil || len(c.RemediationTags) == 0 {
+		return defaultConfig, nil, nil
+	}
+
+	reqTags, ok := c.RequiredTags[typeName]
+	if !ok {
+		return defaultConfig, nil, nil
+	}
+
+	remediated := make(KeyValueTags)
+	var d *RemediationData
+
+	for _, k := range reqTags.Removed(defaultConfig.GetTags()).Keys() {
+		t, ok := c.RemediationTags[k]
+		if !ok {
+			continue
+		}
+
+		if d == nil {
+			d = new(RemediationData)
+			*d = data()
+			d.ResourceType = typeName
+		}
+
+		var sb strings.Builder
+		if err := t.Execute(&sb, d); err != nil {
+			return nil, nil, fmt.Errorf("remediating tag %q: %w", k, err)
+		}
+		v := sb.String()
+		remediated[k] = &TagData{Value: &v}
+	}
+
+	if len(remediated) == 0 {
+		return defaultConfig, nil, nil
+	}
+
+	return &DefaultConfig{Tags: remediated.Merge(defaultConfig.GetTags())}, remediated, nil
+}
+
+// TagPolicyRule contains the constraints that a tag policy enforces on a tag key.
+type TagPolicyRule struct {
+	// Key is the tag key, with the capitalization required by the policy.
+	Key string
+
+	// Values are the allowed tag values, which may contain "*" wildcards.
+	// If empty, any value is allowed.
+	Values []string
+}
+
+// Violations returns descriptions of the ways in which tags don't comply with the
+// tag policy rules enforced for resources of type typeName.
+func (c *TagPolicyConfig) Violations(typeName string, tags KeyValueTags) []string {
+	if c == nil {
+		return nil
+	}
+
+	var violations []string
+
+	for _, rule := range c.Rules[typeName] {
+		for _, k := range slices.Sorted(maps.Keys(tags)) {
+			if !strings.EqualFold(k, rule.Key) {
+				continue
+			}
+
+			if k != rule.Key {
+				violations = append(violations, fmt.Sprintf("tag key %q must be capitalized as %q", k, rule.Key))
+			}
+
+			if v := tags[k].ValueString(); len(rule.Values) > 0 && !slices.ContainsFunc(rule.Values, func(pattern string) bool {
+				return matchWildcard(pattern, v)
+			}) {
+				violations = append(violations, fmt.Sprintf("tag %q value %q is not one of the allowed values %q", k, v, rule.Values))
+			}
+		}
+	}
+
+	return violations
+}
+
+// matchWildcard reports whether s matches pattern, in which "*" matches any sequence of characters.
+func matchWildcard(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+
+	prefix, suffix := parts[0], parts[len(parts)-1]
+	if !strings.HasPrefix(s, prefix) {
+		return false
+	}
+	s = s[len(prefix):]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+
+	return strings.HasSuffix(s, suffix)
+}
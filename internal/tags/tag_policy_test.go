@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewRemediationTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tags    map[string]string
+		wantErr bool
+	}{
+		"static value": {
+			tags: map[string]string{"Owner": "platform-team"},
+		},
+		"template value": {
+			tags: map[string]string{"Component": `{{ .ResourceType | trimPrefix "aws_" | upper }}`},
+		},
+		"syntax error": {
+			tags:    map[string]string{"Owner": "{{ .AccountID"},
+			wantErr: true,
+		},
+		"unknown field": {
+			tags:    map[string]string{"Owner": "{{ .Owner }}"},
+			wantErr: true,
+		},
+		"unknown function": {
+			tags:    map[string]string{"Owner": "{{ .AccountID | title }}"},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewRemediationTags(testCase.tags)
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("NewRemediationTags() error = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestTagPolicyConfigRemediatedDefaultConfig(t *testing.T) {
+	t.Parallel()
+
+	remediationTags, err := NewRemediationTags(map[string]string{
+		"CostCenter": "{{ .AccountID }}",
+		"Component":  `{{ .ResourceType | trimPrefix "aws_" | replace "_" "-" }}`,
+		"Owner":      "platform-team",
+		"Unrequired": "value",
+	})
+	if err != nil {
+		t.Fatalf("NewRemediationTags: %s", err)
+	}
+
+	data := func() RemediationData {
+		return RemediationData{AccountID: "123456789012"}
+	}
+
+	testCases := map[string]struct {
+		policy         *TagPolicyConfig
+		defaultConfig  *DefaultConfig
+		typeName       string
+		wantDefaults   map[string]string
+		wantRemediated map[string]string
+	}{
+		"nil policy": {
+			defaultConfig: &DefaultConfig{Tags: New(t.Context(), map[string]string{"Owner": "default"})},
+			typeName:      "aws_cloudwatch_log_group",
+			wantDefaults:  map[string]string{"Owner": "default"},
+		},
+		"no remediation": {
+			policy: &TagPolicyConfig{
+				RequiredTags: map[string]KeyValueTags{"aws_cloudwatch_log_group": New(t.Context(), []string{"Owner"})},
+			},
+			typeName: "aws_cloudwatch_log_group",
+		},
+		"not required": {
+			policy: &TagPolicyConfig{
+				RequiredTags:    map[string]KeyValueTags{"aws_cloudwatch_log_group": New(t.Context(), []string{"Owner"})},
+				RemediationTags: remediationTags,
+			},
+			typeName: "aws_s3_bucket",
+		},
+		"remediated": {
+			policy: &TagPolicyConfig{
+				RequiredTags:    map[string]KeyValueTags{"aws_cloudwatch_log_group": New(t.Context(), []string{"Component", "CostCenter", "Owner", "Project"})},
+				RemediationTags: remediationTags,
+			},
+			typeName:       "aws_cloudwatch_log_group",
+			wantDefaults:   map[string]string{"Component": "cloudwatch-log-group", "CostCenter": "123456789012", "Owner": "platform-team"},
+			wantRemediated: map[string]string{"Component": "cloudwatch-log-group", "CostCenter": "123456789012", "Owner": "platform-team"},
+		},
+		"default tags take precedence": {
+			policy: &TagPolicyConfig{
+				RequiredTags:    map[string]KeyValueTags{"aws_cloudwatch_log_group": New(t.Context(), []string{"CostCenter", "Owner"})},
+				RemediationTags: remediationTags,
+			},
+			defaultConfig:  &DefaultConfig{Tags: New(t.Context(), map[string]string{"Environment": "test", "Owner": "default"})},
+			typeName:       "aws_cloudwatch_log_group",
+			wantDefaults:   map[string]string{"CostCenter": "123456789012", "Environment": "test", "Owner": "default"},
+			wantRemediated: map[string]string{"CostCenter": "123456789012"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotDefaultConfig, gotRemediated, err := testCase.policy.RemediatedDefaultConfig(testCase.defaultConfig, testCase.typeName, data)
+			if err != nil {
+				t.Fatalf("RemediatedDefaultConfig: %s", err)
+			}
+
+			if got, want := gotDefaultConfig.GetTags(), New(t.Context(), testCase.wantDefaults); !got.Equal(want) && (len(got) > 0 || len(want) > 0) {
+				t.Errorf("default tags = %s, want %s", got, want)
+			}
+			if got, want := gotRemediated, New(t.Context(), testCase.wantRemediated); !got.Equal(want) && (len(got) > 0 || len(want) > 0) {
+				t.Errorf("remediated tags = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestTagPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	policy := &TagPolicyConfig{
+		Rules: map[string][]TagPolicyRule{
+			"aws_secretsmanager_secret": {
+				{Key: "CostCenter", Values: []string{"100", "200*", "*-dev"}},
+				{Key: "Owner"},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		typeName string
+		tags     map[string]string
+		want     []string
+	}{
+		"no rules": {
+			typeName: "aws_cloudwatch_log_group",
+			tags:     map[string]string{"costcenter": "999"},
+		},
+		"compliant": {
+			typeName: "aws_secretsmanager_secret",
+			tags:     map[string]string{"CostCenter": "100", "Owner": "me", "Other": "value"},
+		},
+		"wildcard values": {
+			typeName: "aws_secretsmanager_secret",
+			tags:     map[string]string{"CostCenter": "200-a", "costcenter": "team-dev"},
+			want:     []string{`tag key "costcenter" must be capitalized as "CostCenter"`},
+		},
+		"value not allowed": {
+			typeName: "aws_secretsmanager_secret",
+			tags:     map[string]string{"CostCenter": "300"},
+			want:     []string{`tag "CostCenter" value "300" is not one of the allowed values ["100" "200*" "*-dev"]`},
+		},
+		"key capitalization": {
+			typeName: "aws_secretsmanager_secret",
+			tags:     map[string]string{"OWNER": "me"},
+			want:     []string{`tag key "OWNER" must be capitalized as "Owner"`},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := policy.Violations(testCase.typeName, New(t.Context(), testCase.tags))
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ReadPolicyFile reads the required tags and tag rules per Terraform resource type from
+// the AWS Organizations tag policy document in the named file.
+//
+// Both policy syntax (values set with the "@@assign" operator) and effective policy syntax
+// (plain values) are supported.
+func ReadPolicyFile(ctx context.Context, name string) (map[string]tftags.KeyValueTags, map[string][]tftags.TagPolicyRule, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var doc policyDocument
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, nil, fmt.Errorf("parsing tag policy document (%s): %w", name, err)
+	}
+
+	reqTags, rules := doc.expand(ctx)
+
+	return reqTags, rules, nil
+}
+
+type policyDocument struct {
+	Tags map[string]policyTag `json:"tags"`
+}
+
+type policyTag struct {
+	EnforcedFor          policyValue `json:"enforced_for"`
+	ReportRequiredTagFor policyValue `json:"report_required_tag_for"`
+	TagKey               policyValue `json:"tag_key"`
+	TagValue             policyValue `json:"tag_value"`
+}
+
+func (doc policyDocument) expand(ctx context.Context) (map[string]tftags.KeyValueTags, map[string][]tftags.TagPolicyRule) {
+	reqTags := make(map[string]tftags.KeyValueTags)
+	rules := make(map[string][]tftags.TagPolicyRule)
+
+	for _, name := range slices.Sorted(maps.Keys(doc.Tags)) {
+		tag := doc.Tags[name]
+
+		// The tag_key value specifies the key's required capitalization.
+		key := name
+		if len(tag.TagKey) > 0 {
+			key = tag.TagKey[0]
+		}
+
+		for _, tfType := range terraformTypes(tag.ReportRequiredTagFor) {
+			reqTags[tfType] = reqTags[tfType].Merge(tftags.New(ctx, []string{key}))
+		}
+
+		rule := tftags.TagPolicyRule{
+			Key:    key,
+			Values: tag.TagValue,
+		}
+		for _, tfType := range terraformTypes(tag.EnforcedFor) {
+			rules[tfType] = append(rules[tfType], rule)
+		}
+	}
+
+	return reqTags, rules
+}
+
+// terraformTypes returns the Terraform resource types corresponding to the specified tag resource types.
+// A tag resource type of the form "service:*" or "service:ALL_SUPPORTED" corresponds to all of the service's resource types.
+func terraformTypes(tagResourceTypes []string) []string {
+	var tfTypes []string
+
+	for _, tagResourceType := range tagResourceTypes {
+		service, resourceType, _ := strings.Cut(tagResourceType, ":")
+
+		switch resourceType {
+		case "*", "ALL_SUPPORTED":
+			for _, k := range slices.Sorted(maps.Keys(Lookup)) {
+				if strings.HasPrefix(k, service+":") {
+					tfTypes = append(tfTypes, Lookup[k]...)
+				}
+			}
+		default:
+			tfTypes = append(tfTypes, Lookup[tagResourceType]...)
+		}
+	}
+
+	slices.Sort(tfTypes)
+
+	return slices.Compact(tfTypes)
+}
+
+// policyValue is a tag policy value.
+// In a policy document, values are set with inheritance operators. In an effective policy, values are set directly.
+type policyValue []string
+
+func (v *policyValue) UnmarshalJSON(b []byte) error {
+	var operators map[string]json.RawMessage
+	if err := json.Unmarshal(b, &operators); err != nil {
+		return unmarshalStrings(b, (*[]string)(v))
+	}
+
+	// Other operators, e.g. "@@remove", only affect values inherited from parent policies.
+	for _, operator := range []string{"@@assign", "@@append"} {
+		raw, ok := operators[operator]
+		if !ok {
+			continue
+		}
+
+		var values []string
+		if err := unmarshalStrings(raw, &values); err != nil {
+			return fmt.Errorf("%s: %w", operator, err)
+		}
+		*v = append(*v, values...)
+	}
+
+	return nil
+}
+
+// unmarshalStrings unmarshals a JSON string or array of strings.
+func unmarshalStrings(b []byte, v *[]string) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*v = append(*v, s)
+		return nil
+	}
+
+	var l []string
+	if err := json.Unmarshal(b, &l); err != nil {
+		return err
+	}
+	*v = append(*v, l...)
+
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestReadPolicyFile(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	testCases := map[string]struct {
+		document    string
+		wantReqTags map[string][]string
+		wantRules   map[string][]tftags.TagPolicyRule
+		wantErr     bool
+		wantNoFile  bool
+	}{
+		"policy syntax": {
+			document: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200*"]},
+      "enforced_for": {"@@assign": ["secretsmanager:*"]},
+      "report_required_tag_for": {"@@assign": ["logs:log-group", "secretsmanager:secret"]}
+    },
+    "owner": {
+      "tag_key": {"@@assign": "Owner", "@@operators_allowed_for_child_policies": ["@@none"]},
+      "report_required_tag_for": {"@@assign": ["logs:log-group"]}
+    }
+  }
+}`,
+			wantReqTags: map[string][]string{
+				"aws_cloudwatch_log_group":  {"CostCenter", "Owner"},
+				"aws_secretsmanager_secret": {"CostCenter"},
+			},
+			wantRules: map[string][]tftags.TagPolicyRule{
+				"aws_secretsmanager_secret": {{Key: "CostCenter", Values: []string{"100", "200*"}}},
+			},
+		},
+		"effective policy syntax": {
+			document: `{
+  "tags": {
+    "project": {
+      "tag_key": "Project",
+      "tag_value": ["alpha", "beta"],
+      "enforced_for": ["logs:log-group", "unknown:resource"]
+    }
+  }
+}`,
+			wantReqTags: map[string][]string{},
+			wantRules: map[string][]tftags.TagPolicyRule{
+				"aws_cloudwatch_log_group": {{Key: "Project", Values: []string{"alpha", "beta"}}},
+			},
+		},
+		"invalid JSON": {
+			document: `{"tags": {"owner": {"tag_key": 1}}}`,
+			wantErr:  true,
+		},
+		"no file": {
+			wantNoFile: true,
+			wantErr:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			filename := filepath.Join(t.TempDir(), "policy.json")
+			if !testCase.wantNoFile {
+				if err := os.WriteFile(filename, []byte(testCase.document), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			reqTags, rules, err := ReadPolicyFile(ctx, filename)
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("ReadPolicyFile() error = %v, want error %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			gotReqTags := make(map[string][]string)
+			for k, v := range reqTags {
+				gotReqTags[k] = v.Keys()
+			}
+			if diff := cmp.Diff(gotReqTags, testCase.wantReqTags, cmpSortStrings); diff != "" {
+				t.Errorf("unexpected required tags diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(rules, testCase.wantRules); diff != "" {
+				t.Errorf("unexpected rules diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+var cmpSortStrings = cmp.Transformer("sort", func(in []string) []string {
+	out := append([]string(nil), in...)
+	slices.Sort(out)
+	return out
+})
@@ -0,0 +1,278 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// fileExporter is a span exporter that appends spans to a file in the OTLP/JSON format,
+// one ExportTraceServiceRequest per line, as read by the OpenTelemetry Collector's
+// otlpjsonfile receiver.
+type fileExporter struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+var _ sdktrace.SpanExporter = (*fileExporter)(nil)
+
+func newFileExporter(fileName string) (*fileExporter, error) {
+	file, err := os.OpenFile(fileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600) //nolint:mnd
+	if err != nil {
+		return nil, fmt.Errorf("opening trace file (%s): %w", fileName, err)
+	}
+
+	return &fileExporter{
+		file: file,
+	}, nil
+}
+
+func (e *fileExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	b, err := json.Marshal(newOTLPTracesData(spans))
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.file == nil {
+		return nil
+	}
+
+	_, err = e.file.Write(b)
+
+	return err
+}
+
+func (e *fileExporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.file == nil {
+		return nil
+	}
+
+	err := e.file.Close()
+	e.file = nil
+
+	return err
+}
+
+// OTLP/JSON encoding of the TracesData protobuf message.
+// See https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding.
+
+type otlpTracesData struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	SchemaURL  string           `json:"schemaUrl,omitempty"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeSpans struct {
+	Scope     otlpScope  `json:"scope"`
+	Spans     []otlpSpan `json:"spans"`
+	SchemaURL string     `json:"schemaUrl,omitempty"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Events            []otlpEvent    `json:"events,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	Name         string         `json:"name"`
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	BoolValue   *bool           `json:"boolValue,omitempty"`
+	IntValue    *string         `json:"intValue,omitempty"` // int64 values are encoded as strings.
+	DoubleValue *float64        `json:"doubleValue,omitempty"`
+	ArrayValue  *otlpArrayValue `json:"arrayValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []otlpAnyValue `json:"values"`
+}
+
+// OTLP status codes. These differ from the OpenTelemetry API's codes.Code values.
+const (
+	otlpStatusCodeUnset = 0
+	otlpStatusCodeOK    = 1
+	otlpStatusCodeError = 2
+)
+
+func newOTLPTracesData(spans []sdktrace.ReadOnlySpan) otlpTracesData {
+	// Spans from a single tracer provider share a resource; group them by instrumentation scope.
+	var (
+		scopes     []instrumentation.Scope
+		scopeSpans = make(map[instrumentation.Scope][]otlpSpan)
+	)
+	for _, span := range spans {
+		scope := span.InstrumentationScope()
+		if _, ok := scopeSpans[scope]; !ok {
+			scopes = append(scopes, scope)
+		}
+		scopeSpans[scope] = append(scopeSpans[scope], newOTLPSpan(span))
+	}
+
+	resourceSpans := otlpResourceSpans{
+		Resource: otlpResource{
+			Attributes: newOTLPKeyValues(spans[0].Resource().Attributes()),
+		},
+		SchemaURL: spans[0].Resource().SchemaURL(),
+	}
+	for _, scope := range scopes {
+		resourceSpans.ScopeSpans = append(resourceSpans.ScopeSpans, otlpScopeSpans{
+			Scope: otlpScope{
+				Name:    scope.Name,
+				Version: scope.Version,
+			},
+			Spans:     scopeSpans[scope],
+			SchemaURL: scope.SchemaURL,
+		})
+	}
+
+	return otlpTracesData{
+		ResourceSpans: []otlpResourceSpans{resourceSpans},
+	}
+}
+
+func newOTLPSpan(span sdktrace.ReadOnlySpan) otlpSpan {
+	apiObject := otlpSpan{
+		TraceID:           span.SpanContext().TraceID().String(),
+		SpanID:            span.SpanContext().SpanID().String(),
+		Name:              span.Name(),
+		Kind:              int(span.SpanKind()), // The API's SpanKind values match OTLP's.
+		StartTimeUnixNano: strconv.FormatInt(span.StartTime().UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.EndTime().UnixNano(), 10),
+		Attributes:        newOTLPKeyValues(span.Attributes()),
+	}
+
+	if parent := span.Parent(); parent.IsValid() {
+		apiObject.ParentSpanID = parent.SpanID().String()
+	}
+
+	for _, event := range span.Events() {
+		apiObject.Events = append(apiObject.Events, otlpEvent{
+			TimeUnixNano: strconv.FormatInt(event.Time.UnixNano(), 10),
+			Name:         event.Name,
+			Attributes:   newOTLPKeyValues(event.Attributes),
+		})
+	}
+
+	switch status := span.Status(); status.Code {
+	case codes.Ok:
+		apiObject.Status.Code = otlpStatusCodeOK
+	case codes.Error:
+		apiObject.Status.Code = otlpStatusCodeError
+		apiObject.Status.Message = status.Description
+	default:
+		apiObject.Status.Code = otlpStatusCodeUnset
+	}
+
+	return apiObject
+}
+
+func newOTLPKeyValues(attrs []attribute.KeyValue) []otlpKeyValue {
+	var apiObjects []otlpKeyValue
+
+	for _, attr := range attrs {
+		apiObjects = append(apiObjects, otlpKeyValue{
+			Key:   string(attr.Key),
+			Value: newOTLPAnyValue(attr.Value),
+		})
+	}
+
+	return apiObjects
+}
+
+func newOTLPAnyValue(v attribute.Value) otlpAnyValue {
+	var apiObject otlpAnyValue
+
+	switch v.Type() {
+	case attribute.BOOL:
+		b := v.AsBool()
+		apiObject.BoolValue = &b
+	case attribute.INT64:
+		s := strconv.FormatInt(v.AsInt64(), 10)
+		apiObject.IntValue = &s
+	case attribute.FLOAT64:
+		f := v.AsFloat64()
+		apiObject.DoubleValue = &f
+	case attribute.BOOLSLICE:
+		apiObject.ArrayValue = newOTLPArrayValue(v.AsBoolSlice(), attribute.BoolValue)
+	case attribute.INT64SLICE:
+		apiObject.ArrayValue = newOTLPArrayValue(v.AsInt64Slice(), attribute.Int64Value)
+	case attribute.FLOAT64SLICE:
+		apiObject.ArrayValue = newOTLPArrayValue(v.AsFloat64Slice(), attribute.Float64Value)
+	case attribute.STRINGSLICE:
+		apiObject.ArrayValue = newOTLPArrayValue(v.AsStringSlice(), attribute.StringValue)
+	default:
+		s := v.Emit()
+		apiObject.StringValue = &s
+	}
+
+	return apiObject
+}
+
+func newOTLPArrayValue[T any](vs []T, f func(T) attribute.Value) *otlpArrayValue {
+	apiObject := &otlpArrayValue{
+		Values: make([]otlpAnyValue, 0, len(vs)),
+	}
+
+	for _, v := range vs {
+		apiObject.Values = append(apiObject.Values, newOTLPAnyValue(f(v)))
+	}
+
+	return apiObject
+}
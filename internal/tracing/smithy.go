@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/aws/smithy-go"
+	smithytracing "github.com/aws/smithy-go/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ServiceOptions returns a function, for use in aws.Config.ServiceOptions, that sets
+// each AWS SDK for Go v2 service client's TracerProvider to one backed by the global
+// OpenTelemetry tracer provider.
+//
+// The SDK then records each API operation as a span, with child spans for each retry attempt.
+func ServiceOptions() func(string, any) {
+	tp := NewSmithyTracerProvider(otel.GetTracerProvider())
+
+	return func(_ string, options any) {
+		setTracerProvider(options, tp)
+	}
+}
+
+var tracerProviderType = reflect.TypeFor[smithytracing.TracerProvider]()
+
+// setTracerProvider sets the TracerProvider field of a service client's Options.
+// Each service has its own Options type, so the field is set by reflection.
+func setTracerProvider(options any, tp smithytracing.TracerProvider) {
+	v := reflect.ValueOf(options)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+
+	if f := v.Elem().FieldByName("TracerProvider"); f.IsValid() && f.CanSet() && f.Type() == tracerProviderType {
+		f.Set(reflect.ValueOf(tp))
+	}
+}
+
+// NewSmithyTracerProvider adapts an OpenTelemetry tracer provider to the Smithy tracing API.
+func NewSmithyTracerProvider(tp trace.TracerProvider) smithytracing.TracerProvider {
+	return &smithyTracerProvider{tp: tp}
+}
+
+type smithyTracerProvider struct {
+	tp trace.TracerProvider
+}
+
+func (p *smithyTracerProvider) Tracer(scope string, _ ...smithytracing.TracerOption) smithytracing.Tracer {
+	return &smithyTracer{tracer: p.tp.Tracer(scope)}
+}
+
+type smithyTracer struct {
+	tracer trace.Tracer
+}
+
+func (t *smithyTracer) StartSpan(ctx context.Context, name string, optFns ...smithytracing.SpanOption) (context.Context, smithytracing.Span) {
+	var options smithytracing.SpanOptions
+	for _, optFn := range optFns {
+		optFn(&options)
+	}
+
+	ctx, span := t.tracer.Start(ctx, name,
+		trace.WithSpanKind(spanKind(options.Kind)),
+		trace.WithAttributes(attributes(&options.Properties)...),
+	)
+
+	return ctx, &smithySpan{name: name, span: span}
+}
+
+type smithySpan struct {
+	name string
+	span trace.Span
+}
+
+func (s *smithySpan) Name() string {
+	return s.name
+}
+
+func (s *smithySpan) Context() smithytracing.SpanContext {
+	spanContext := s.span.SpanContext()
+
+	return smithytracing.SpanContext{
+		TraceID:  spanContext.TraceID().String(),
+		SpanID:   spanContext.SpanID().String(),
+		IsRemote: spanContext.IsRemote(),
+	}
+}
+
+func (s *smithySpan) AddEvent(name string, optFns ...smithytracing.EventOption) {
+	var options smithytracing.EventOptions
+	for _, optFn := range optFns {
+		optFn(&options)
+	}
+
+	s.span.AddEvent(name, trace.WithAttributes(attributes(&options.Properties)...))
+}
+
+func (s *smithySpan) SetStatus(status smithytracing.SpanStatus) {
+	switch status {
+	case smithytracing.SpanStatusOK:
+		s.span.SetStatus(codes.Ok, "")
+	case smithytracing.SpanStatusError:
+		s.span.SetStatus(codes.Error, "")
+	}
+}
+
+func (s *smithySpan) SetProperty(k, v any) {
+	s.span.SetAttributes(attributeOf(k, v))
+}
+
+func (s *smithySpan) End() {
+	s.span.End()
+}
+
+func spanKind(kind smithytracing.SpanKind) trace.SpanKind {
+	switch kind {
+	case smithytracing.SpanKindClient:
+		return trace.SpanKindClient
+	case smithytracing.SpanKindServer:
+		return trace.SpanKindServer
+	case smithytracing.SpanKindProducer:
+		return trace.SpanKindProducer
+	case smithytracing.SpanKindConsumer:
+		return trace.SpanKindConsumer
+	default:
+		return trace.SpanKindInternal
+	}
+}
+
+func attributes(properties *smithy.Properties) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+
+	for k, v := range properties.Values() {
+		attrs = append(attrs, attributeOf(k, v))
+	}
+
+	return attrs
+}
+
+func attributeOf(k, v any) attribute.KeyValue {
+	key := attribute.Key(fmt.Sprint(k))
+
+	switch v := v.(type) {
+	case string:
+		return key.String(v)
+	case bool:
+		return key.Bool(v)
+	case int:
+		return key.Int(v)
+	case int64:
+		return key.Int64(v)
+	case float64:
+		return key.Float64(v)
+	case []string:
+		return key.StringSlice(v)
+	default:
+		return key.String(fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tracing provides opt-in OpenTelemetry tracing of provider operations.
+//
+// Tracing is enabled by setting the TF_AWS_OTEL_TRACES_EXPORTER environment variable:
+//
+//   - "otlp" exports spans using OTLP/HTTP to a collector configured by the standard
+//     OTEL_EXPORTER_OTLP_ENDPOINT (or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT) environment variables.
+//   - "file" appends spans in OTLP/JSON format to the file named by TF_AWS_OTEL_TRACES_FILE.
+//
+// Each resource, data source, and ephemeral resource CRUD handler invocation is a parent span.
+// AWS API operations, their retry attempts, and state-change waiter polls are child spans.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.38.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// EnvVarExporter is the environment variable that enables tracing and selects the span exporter.
+	EnvVarExporter = "TF_AWS_OTEL_TRACES_EXPORTER"
+	// EnvVarFile is the environment variable naming the file spans are written to by the "file" exporter.
+	EnvVarFile = "TF_AWS_OTEL_TRACES_FILE"
+
+	ExporterFile = "file"
+	ExporterOTLP = "otlp"
+)
+
+const (
+	instrumentationName = "github.com/hashicorp/terraform-provider-aws"
+	serviceName         = "terraform-provider-aws"
+)
+
+const (
+	AttrKeyOperation      = attribute.Key("tf_aws.operation")
+	AttrKeyResourceType   = attribute.Key("tf_aws.resource_type")
+	AttrKeyServicePackage = attribute.Key("tf_aws.service_package")
+)
+
+// Enabled returns whether tracing is enabled.
+var Enabled = sync.OnceValue(func() bool {
+	return os.Getenv(EnvVarExporter) != ""
+})
+
+// Start installs the global OpenTelemetry tracer provider if tracing is enabled.
+// The returned function flushes any buffered spans and must be called before the process exits.
+func Start(ctx context.Context) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	if !Enabled() {
+		return noop, nil
+	}
+
+	res, err := sdkresource.Merge(sdkresource.Default(), sdkresource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version.ProviderVersion),
+	))
+	if err != nil {
+		return noop, fmt.Errorf("creating OpenTelemetry resource: %w", err)
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+	}
+
+	switch v := os.Getenv(EnvVarExporter); v {
+	case ExporterFile:
+		fileName := os.Getenv(EnvVarFile)
+		if fileName == "" {
+			return noop, fmt.Errorf("%s must be set when %s is %q", EnvVarFile, EnvVarExporter, v)
+		}
+
+		exporter, err := newFileExporter(fileName)
+		if err != nil {
+			return noop, err
+		}
+
+		// Write spans as they end so that none are lost if the provider is stopped abruptly.
+		opts = append(opts, sdktrace.WithSyncer(exporter))
+	case ExporterOTLP:
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return noop, fmt.Errorf("creating OTLP trace exporter: %w", err)
+		}
+
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	default:
+		return noop, fmt.Errorf("unsupported %s value: %q", EnvVarExporter, v)
+	}
+
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// StartSpan starts a span using the global tracer provider.
+// If tracing is not enabled the span is a no-op.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends span, recording err, if any.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// EndSpanWithStatus ends span, setting an error status with the specified description if failed is true.
+// Used with Terraform diagnostics, which are not errors.
+func EndSpanWithStatus(span trace.Span, failed bool, description string) {
+	if failed {
+		span.SetStatus(codes.Error, description)
+	}
+
+	span.End()
+}
+
+// HandlerSpanName returns the name of the span for a resource handler invocation, e.g. "aws_vpc.Create".
+func HandlerSpanName(typeName, operation string) string {
+	return typeName + "." + operation
+}
+
+// HandlerSpanAttributes returns the attributes of the span for a resource handler invocation.
+func HandlerSpanAttributes(servicePackageName, typeName, operation string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		AttrKeyServicePackage.String(servicePackageName),
+		AttrKeyResourceType.String(typeName),
+		AttrKeyOperation.String(operation),
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	smithytracing "github.com/aws/smithy-go/tracing"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type exampleOptions struct {
+	Region         string
+	TracerProvider smithytracing.TracerProvider
+}
+
+func TestSetTracerProvider(t *testing.T) {
+	t.Parallel()
+
+	tp := NewSmithyTracerProvider(sdktrace.NewTracerProvider())
+
+	var options exampleOptions
+	setTracerProvider(&options, tp)
+	if options.TracerProvider != tp {
+		t.Errorf("TracerProvider not set")
+	}
+
+	// Non-pointer and non-struct values are ignored.
+	setTracerProvider(options, tp)
+	setTracerProvider(new(string), tp)
+}
+
+func TestFileExporter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fileName := filepath.Join(t.TempDir(), "traces.json")
+
+	exporter, err := newFileExporter(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	ctx, parent := tp.Tracer("test").Start(ctx, "aws_vpc.Create")
+	ctx, child := NewSmithyTracerProvider(tp).Tracer("EC2").StartSpan(ctx, "EC2.CreateVpc", func(o *smithytracing.SpanOptions) {
+		o.Kind = smithytracing.SpanKindClient
+		o.Properties.Set("rpc.method", "CreateVpc")
+	})
+	_, attempt := NewSmithyTracerProvider(tp).Tracer("EC2").StartSpan(ctx, "Attempt")
+	attempt.SetStatus(smithytracing.SpanStatusError)
+	attempt.End()
+	child.End()
+	EndSpan(parent, errors.New("creating VPC"))
+
+	if err := tp.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var spans []otlpSpan
+	dec := json.NewDecoder(bytes.NewReader(b))
+	for dec.More() {
+		var v otlpTracesData
+		if err := dec.Decode(&v); err != nil {
+			t.Fatal(err)
+		}
+		for _, rs := range v.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				spans = append(spans, ss.Spans...)
+			}
+		}
+	}
+
+	if got, want := len(spans), 3; got != want {
+		t.Fatalf("len(spans) = %d, want %d", got, want)
+	}
+
+	// Spans are exported as they end.
+	attemptSpan, childSpan, parentSpan := spans[0], spans[1], spans[2]
+
+	if got, want := attemptSpan.ParentSpanID, childSpan.SpanID; got != want {
+		t.Errorf("Attempt parentSpanId = %s, want %s", got, want)
+	}
+	if got, want := attemptSpan.Status.Code, otlpStatusCodeError; got != want {
+		t.Errorf("Attempt status code = %d, want %d", got, want)
+	}
+	if got, want := childSpan.ParentSpanID, parentSpan.SpanID; got != want {
+		t.Errorf("EC2.CreateVpc parentSpanId = %s, want %s", got, want)
+	}
+	if got, want := childSpan.Kind, 3; got != want { // SPAN_KIND_CLIENT
+		t.Errorf("EC2.CreateVpc kind = %d, want %d", got, want)
+	}
+	if got, want := len(childSpan.Attributes), 1; got != want {
+		t.Errorf("len(EC2.CreateVpc attributes) = %d, want %d", got, want)
+	}
+	if parentSpan.ParentSpanID != "" {
+		t.Errorf("aws_vpc.Create parentSpanId = %s, want none", parentSpan.ParentSpanID)
+	}
+	if got, want := parentSpan.Status.Message, "creating VPC"; got != want {
+		t.Errorf("aws_vpc.Create status message = %s, want %s", got, want)
+	}
+	if got, want := len(parentSpan.Events), 1; got != want { // The recorded error.
+		t.Errorf("len(aws_vpc.Create events) = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"github.com/YakDriver/regexache"
+)
+
+// CanonicalIAMRoleARNPatternNoAnchors is the canonical regex pattern for validating IAM role ARNs,
+// without anchors for use within larger regex patterns.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_identifiers.html#identifiers-arns.
+// Uses non-capturing groups to avoid interfering with capture groups in composed patterns.
+const CanonicalIAMRoleARNPatternNoAnchors = `arn:[a-z-]+:iam::` + CanonicalAccountIDPatternNoAnchors + `:role/(?:[\w+=,.@-]+/)*[\w+=,.@-]+`
+
+// CanonicalIAMRoleARNPattern is the anchored version of CanonicalIAMRoleARNPatternNoAnchors.
+const CanonicalIAMRoleARNPattern = `^` + CanonicalIAMRoleARNPatternNoAnchors + `$`
+
+// IsIAMRoleARN returns whether or not the specified string is a valid IAM role ARN.
+func IsIAMRoleARN(s string) bool {
+	return regexache.MustCompile(CanonicalIAMRoleARNPattern).MatchString(s)
+}
@@ -0,0 +1,30 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "testing"
+
+func TestIsIAMRoleARN(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		arn   string
+		valid bool
+	}{
+		{"arn:aws:iam::123456789012:role/example", true},
+		{"arn:aws-us-gov:iam::123456789012:role/example", true},
+		{"arn:aws:iam::123456789012:role/service-role/path/example.role@1", true},
+		{"arn:aws:iam::123456789012:user/example", false},
+		{"arn:aws:iam::12345678901:role/example", false},
+		{"arn:aws:iam:us-west-2:123456789012:role/example", false},
+		{"arn:aws:iam::123456789012:role/", false},
+		{"arn:aws:iam::123456789012:role/example@us-west-2 ", false},
+		{"", false},
+	} {
+		ok := IsIAMRoleARN(tc.arn)
+		if got, want := ok, tc.valid; got != want {
+			t.Errorf("IsIAMRoleARN(%q) = %v, want %v", tc.arn, got, want)
+		}
+	}
+}
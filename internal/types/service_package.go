@@ -56,6 +56,25 @@ func ResourceRegionNoPartitionValidation() unique.Handle[ServicePackageResourceR
 	})
 }
 
+// ServicePackageResourceAssumeRole represents resource-level IAM role information.
+type ServicePackageResourceAssumeRole struct {
+	IsOverrideEnabled bool // Is per-resource IAM role override supported?
+}
+
+// ResourceAssumeRoleOverride returns the resource IAM role configuration indicating that per-resource IAM role override is enabled.
+func ResourceAssumeRoleOverride() unique.Handle[ServicePackageResourceAssumeRole] {
+	return unique.Make(ServicePackageResourceAssumeRole{
+		IsOverrideEnabled: true,
+	})
+}
+
+// ResourceAssumeRoleDisabled returns the resource IAM role configuration indicating that per-resource IAM role override is disabled.
+func ResourceAssumeRoleDisabled() unique.Handle[ServicePackageResourceAssumeRole] {
+	return unique.Make(ServicePackageResourceAssumeRole{
+		IsOverrideEnabled: false,
+	})
+}
+
 // ServicePackageResourceTags represents resource-level tagging information.
 type ServicePackageResourceTags struct {
 	IdentifierAttribute string // The attribute for the identifier for UpdateTags etc.
@@ -93,13 +112,14 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   FrameworkImport
+	Factory    func(context.Context) (resource.ResourceWithConfigure, error)
+	TypeName   string
+	Name       string
+	Tags       unique.Handle[ServicePackageResourceTags]
+	Region     unique.Handle[ServicePackageResourceRegion]
+	AssumeRole unique.Handle[ServicePackageResourceAssumeRole]
+	Identity   Identity
+	Import     FrameworkImport
 }
 
 type ServicePackageFrameworkListResource struct {
@@ -124,13 +144,14 @@ type ServicePackageSDKDataSource struct {
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   SDKv2Import
+	Factory    func() *schema.Resource
+	TypeName   string
+	Name       string
+	Tags       unique.Handle[ServicePackageResourceTags]
+	Region     unique.Handle[ServicePackageResourceRegion]
+	AssumeRole unique.Handle[ServicePackageResourceAssumeRole]
+	Identity   Identity
+	Import     SDKv2Import
 }
 
 type ListResourceForSDK interface {
@@ -193,6 +214,27 @@ func (i Identity) CustomInherentRegionParser() RegionalCustomInherentRegionIdent
 	return i.customInherentRegionParser
 }
 
+// WithAssumeRoleARN returns the identity with an optional "assume_role_arn" attribute,
+// which records any per-resource IAM role override and so the AWS account that the resource is in.
+// The attribute follows any "account_id" attribute, or is otherwise appended, so that the positions
+// of the ARN and single-parameter attributes are unchanged.
+func (i Identity) WithAssumeRoleARN() Identity {
+	if len(i.Attributes) == 0 || slices.ContainsFunc(i.Attributes, func(v IdentityAttribute) bool {
+		return v.Name() == names.AttrAssumeRoleARN
+	}) {
+		return i
+	}
+
+	attr := StringIdentityAttribute(names.AttrAssumeRoleARN, false)
+	if i.Attributes[0].Name() == names.AttrAccountID {
+		i.Attributes = slices.Insert(slices.Clone(i.Attributes), 1, attr)
+	} else {
+		i.Attributes = append(slices.Clone(i.Attributes), attr)
+	}
+
+	return i
+}
+
 func RegionalParameterizedIdentity(attributes []IdentityAttribute, opts ...IdentityOptsFunc) Identity {
 	baseAttributes := []IdentityAttribute{
 		StringIdentityAttribute("account_id", false),
@@ -6,16 +6,19 @@ package vcr
 import (
 	"fmt"
 	"os"
+	"strconv"
 
 	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
 )
 
 const (
-	envVarVCRMode = "VCR_MODE"
-	envVarVCRPath = "VCR_PATH"
+	envVarVCRMode           = "VCR_MODE"
+	envVarVCRPath           = "VCR_PATH"
+	envVarVCRReplayRedacted = "VCR_REPLAY_REDACTED"
 
-	vcrModeRecordOnly = "RECORD_ONLY"
-	vcrModeReplayOnly = "REPLAY_ONLY"
+	vcrModeRecordOnly    = "RECORD_ONLY"
+	vcrModeReplayOnly    = "REPLAY_ONLY"
+	vcrModeReplayOffline = "REPLAY_OFFLINE"
 )
 
 // IsEnabled indicates whether VCR testing is enabled
@@ -31,14 +34,32 @@ func Mode() (recorder.Mode, error) {
 	switch v := os.Getenv(envVarVCRMode); v {
 	case vcrModeRecordOnly:
 		return recorder.ModeRecordOnly, nil
-	case vcrModeReplayOnly:
+	case vcrModeReplayOnly, vcrModeReplayOffline:
 		return recorder.ModeReplayOnly, nil
 	default:
 		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", envVarVCRMode, v)
 	}
 }
 
+// IsOffline indicates whether VCR testing is enabled in hermetic replay mode
+//
+// In REPLAY_OFFLINE mode interactions are replayed exactly as in REPLAY_ONLY mode,
+// but no AWS credentials are required: caller identity is read from the
+// cassette's identity file and provider credential validation is skipped.
+func IsOffline() bool {
+	return IsEnabled() && os.Getenv(envVarVCRMode) == vcrModeReplayOffline
+}
+
 // Path returns the directory in which VCR recordings should be stored
 func Path() string {
 	return os.Getenv(envVarVCRPath)
 }
+
+// ReplayRedacted indicates whether recorded responses are replayed with their redacted placeholders
+//
+// Returns true if the VCR_REPLAY_REDACTED environment variable is set to a true value.
+// By default, account IDs and secrets redacted from responses are restored on replay.
+func ReplayRedacted() bool {
+	v, _ := strconv.ParseBool(os.Getenv(envVarVCRReplayRedacted))
+	return v
+}
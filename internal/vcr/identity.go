@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Identity is the caller identity recorded alongside a cassette
+//
+// It is captured in RECORD_ONLY mode and used in REPLAY_OFFLINE mode in place
+// of the STS GetCallerIdentity call made during provider configuration.
+type Identity struct {
+	AccountID string `json:"account_id"`
+	Partition string `json:"partition"`
+	Region    string `json:"region"`
+}
+
+type identityContextKeyType int
+
+var identityContextKey identityContextKeyType
+
+// NewIdentityContext returns a new context with the provided recorded identity stored
+//
+// The presence of an identity in the context signals provider configuration
+// to run hermetically, without resolving or validating credentials.
+func NewIdentityContext(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityContextKey, identity)
+}
+
+// IdentityFromContext extracts the recorded identity from the context, if present.
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityContextKey).(*Identity)
+	return identity, ok && identity != nil
+}
+
+// ReadIdentityFromFile reads a recorded identity from the specified file.
+func ReadIdentityFromFile(fileName string) (*Identity, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	var identity Identity
+	if err := json.Unmarshal(data, &identity); err != nil {
+		return nil, fmt.Errorf("reading VCR identity (%s): %w", fileName, err)
+	}
+
+	if identity.AccountID == "" || identity.Partition == "" {
+		return nil, fmt.Errorf("reading VCR identity (%s): account ID and partition are required", fileName)
+	}
+
+	return &identity, nil
+}
+
+// WriteIdentityToFile writes a recorded identity to the specified file.
+func WriteIdentityToFile(identity *Identity, fileName string) error {
+	data, err := json.MarshalIndent(identity, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(fileName, data, 0644) //nolint:mnd
+}
@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"maps"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var (
+	volatileFieldsLock sync.RWMutex
+	// volatileFields holds, by service signing name, request fields whose values
+	// differ between runs and so are excluded from request matching.
+	// Fields under the empty key apply to all services.
+	volatileFields = map[string][]string{
+		"": {
+			"ClientRequestToken",
+			"ClientToken",
+			"IdempotencyToken",
+			// Presigned URL query parameters.
+			"X-Amz-Credential",
+			"X-Amz-Date",
+			"X-Amz-Security-Token",
+			"X-Amz-Signature",
+		},
+		"monitoring": {
+			"EndTime",
+			"StartTime",
+		},
+	}
+)
+
+// IgnoreFields excludes the named request fields from matching for the specified
+// service, identified by its signing name (e.g. "ec2", "iam", "sqs").
+// An empty service name applies the fields to all services.
+//
+// Fields are matched by name at any depth in JSON bodies, by the last component
+// of the parameter name in query protocol bodies, and by parameter name in URL
+// query strings.
+func IgnoreFields(service string, fields ...string) {
+	volatileFieldsLock.Lock()
+	defer volatileFieldsLock.Unlock()
+
+	for _, field := range fields {
+		if !slices.Contains(volatileFields[service], field) {
+			volatileFields[service] = append(volatileFields[service], field)
+		}
+	}
+}
+
+// IgnoredFields returns the request fields excluded from matching for the specified service.
+func IgnoredFields(service string) []string {
+	volatileFieldsLock.RLock()
+	defer volatileFieldsLock.RUnlock()
+
+	fields := slices.Clone(volatileFields[""])
+	if service != "" {
+		fields = append(fields, volatileFields[service]...)
+	}
+
+	return fields
+}
+
+var credentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/[^/]+/[^/]+/([^/]+)/aws4_request`)
+
+// ServiceFromRequest returns the signing name of the AWS service targeted by r.
+//
+// The signing name is read from the SigV4 credential scope in the Authorization
+// header, falling back to the first label of the request host.
+func ServiceFromRequest(r *http.Request) string {
+	if m := credentialScopeRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		return m[1]
+	}
+
+	host, _, _ := strings.Cut(r.URL.Hostname(), ".")
+
+	return host
+}
+
+// EqualURLs reports whether two URLs are equal, ignoring the order of query
+// string parameters and the values of the specified parameters.
+func EqualURLs(a, b string, ignore []string) bool {
+	if a == b {
+		return true
+	}
+
+	ua, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	ub, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+
+	qa, qb := ua.Query(), ub.Query()
+	ua.RawQuery, ub.RawQuery = "", ""
+	if ua.String() != ub.String() {
+		return false
+	}
+
+	return equalValues(qa, qb, ignore)
+}
+
+// EqualQueryBodies reports whether two AWS query protocol request bodies are
+// equal, ignoring the order of parameters and the values of the specified fields.
+func EqualQueryBodies(a, b string, ignore []string) bool {
+	va, err := url.ParseQuery(a)
+	if err != nil {
+		return false
+	}
+	vb, err := url.ParseQuery(b)
+	if err != nil {
+		return false
+	}
+
+	return equalValues(va, vb, ignore)
+}
+
+func equalValues(a, b url.Values, ignore []string) bool {
+	deleteFunc := func(k string, _ []string) bool {
+		// Query protocol keys are dot-separated paths, e.g. "TagSpecification.1.ClientToken".
+		if i := strings.LastIndex(k, "."); i >= 0 {
+			k = k[i+1:]
+		}
+		return slices.Contains(ignore, k)
+	}
+	maps.DeleteFunc(a, deleteFunc)
+	maps.DeleteFunc(b, deleteFunc)
+
+	return reflect.DeepEqual(a, b)
+}
+
+// StripJSONFields returns the JSON document s with the specified fields removed
+// from every object. s is returned unchanged if it is not valid JSON or has no
+// such fields.
+func StripJSONFields(s string, ignore []string) string {
+	if len(ignore) == 0 {
+		return s
+	}
+
+	return rewriteJSON(s, func(m map[string]any) bool {
+		var changed bool
+		for _, field := range ignore {
+			if _, ok := m[field]; ok {
+				delete(m, field)
+				changed = true
+			}
+		}
+		return changed
+	})
+}
@@ -0,0 +1,538 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+const (
+	envVarVCRRedactAccountIDs = "VCR_REDACT_ACCOUNT_IDS"
+)
+
+// Protocol is the AWS wire protocol family of an HTTP message body.
+type Protocol int
+
+const (
+	ProtocolUnknown Protocol = iota
+	ProtocolJSON             // awsJson1_0, awsJson1_1, restJson1
+	ProtocolXML              // restXml, awsQuery and ec2Query responses
+	ProtocolQuery            // awsQuery and ec2Query requests
+)
+
+// ProtocolFromContentType returns the AWS protocol family for the specified Content-Type header value.
+func ProtocolFromContentType(contentType string) Protocol {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ProtocolUnknown
+	}
+
+	switch {
+	case mediaType == "application/json", strings.HasPrefix(mediaType, "application/x-amz-json"):
+		return ProtocolJSON
+	case mediaType == "application/xml", mediaType == "text/xml":
+		return ProtocolXML
+	case mediaType == "application/x-www-form-urlencoded":
+		return ProtocolQuery
+	default:
+		return ProtocolUnknown
+	}
+}
+
+// Rule rewrites sensitive values in s to stable placeholders.
+//
+// protocol is the AWS protocol family of s when s is an HTTP message body, and
+// ProtocolUnknown for URLs and header values.
+// Rules must be deterministic and idempotent, as they are applied both when
+// cassettes are saved and to live requests when matching on replay.
+type Rule func(s string, protocol Protocol) string
+
+// Redactor is a pipeline of redaction rules applied to recorded interactions.
+//
+// A Redactor is used both as a recorder hook, rewriting interactions before a
+// cassette is saved, and by the request matcher, which applies the same rules to
+// live requests so that they compare equal to the redacted recordings on replay.
+//
+// On replay, a Redactor can also restore the values that it redacted, see RestoreInteraction.
+type Redactor struct {
+	mu                 sync.RWMutex
+	accountIDs         []string
+	accountIDRegexps   []*regexp.Regexp
+	placeholderRegexps []*regexp.Regexp
+	rules              []Rule
+	secrets            map[string]string // Placeholder to redacted value.
+}
+
+// RedactorOption configures a Redactor.
+type RedactorOption func(*Redactor)
+
+// WithAccountIDs adds account IDs to be replaced by placeholders.
+func WithAccountIDs(accountIDs ...string) RedactorOption {
+	return func(r *Redactor) {
+		for _, v := range accountIDs {
+			r.AddAccountID(v)
+		}
+	}
+}
+
+// WithRules appends redaction rules to the pipeline.
+func WithRules(rules ...Rule) RedactorOption {
+	return func(r *Redactor) {
+		r.rules = append(r.rules, rules...)
+	}
+}
+
+// NewRedactor returns a Redactor running the default rules followed by any configured rules.
+//
+// Additional account IDs to redact can be set as a comma-separated list in the
+// VCR_REDACT_ACCOUNT_IDS environment variable.
+func NewRedactor(opts ...RedactorOption) *Redactor {
+	r := &Redactor{}
+
+	r.rules = append(r.rules, r.accountIDRule)
+	r.rules = append(r.rules, defaultRules(r.placeholder, r.blobPlaceholder)...)
+
+	if v := os.Getenv(envVarVCRRedactAccountIDs); v != "" {
+		for accountID := range strings.SplitSeq(v, ",") {
+			r.AddAccountID(strings.TrimSpace(accountID))
+		}
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// DefaultRules returns the built-in redaction rules.
+func DefaultRules() []Rule {
+	return defaultRules(placeholder, blobPlaceholder)
+}
+
+func defaultRules(redact, redactBlob func(string) string) []Rule {
+	return []Rule{
+		fieldsRule(redact,
+			"AccessKeySecret",
+			"MasterUserPassword",
+			"Password",
+			"SecretAccessKey",
+			"SecretString",
+			"ServicePassword",
+			"SessionToken",
+			"SmtpPassword",
+		),
+		fieldsRule(redactBlob,
+			"CiphertextBlob",
+			"CiphertextForRecipient",
+			"Plaintext",
+			"SecretBinary",
+		),
+		secureStringParameterRule(redact),
+		AccessKeyIDRule,
+		PresignedURLRule,
+	}
+}
+
+// AddAccountID adds an account ID to be replaced by a placeholder.
+//
+// Placeholders are assigned in order of addition, so the first account ID added
+// (usually the caller's account) is always redacted to the same value.
+func (r *Redactor) AddAccountID(accountID string) {
+	if !accountIDRegexp.MatchString(accountID) {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !slices.Contains(r.accountIDs, accountID) {
+		r.accountIDs = append(r.accountIDs, accountID)
+		// Match on digit rather than word boundaries so that account IDs in URL-encoded ARNs are also redacted.
+		r.accountIDRegexps = append(r.accountIDRegexps, regexp.MustCompile(`(^|\D)`+accountID+`(\D|$)`))
+		r.placeholderRegexps = append(r.placeholderRegexps, regexp.MustCompile(`(^|\D)`+accountIDPlaceholder(len(r.accountIDs)-1)+`(\D|$)`))
+	}
+}
+
+// AccountID returns the placeholder for the specified account ID.
+// Account IDs not configured for redaction are returned unchanged.
+func (r *Redactor) AccountID(accountID string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if i := slices.Index(r.accountIDs, accountID); i >= 0 {
+		return accountIDPlaceholder(i)
+	}
+
+	return accountID
+}
+
+// Redact applies the pipeline to a URL, header value or other string.
+func (r *Redactor) Redact(s string) string {
+	return r.RedactBody(s, ProtocolUnknown)
+}
+
+// RedactBody applies the pipeline to an HTTP message body of the specified protocol.
+func (r *Redactor) RedactBody(body string, protocol Protocol) string {
+	if body == "" {
+		return body
+	}
+
+	for _, rule := range r.rules {
+		body = rule(body, protocol)
+	}
+
+	return body
+}
+
+// RedactInteraction applies the pipeline to a recorded interaction.
+//
+// It has the signature of a go-vcr recorder hook.
+func (r *Redactor) RedactInteraction(i *cassette.Interaction) error {
+	i.Request.URL = r.Redact(i.Request.URL)
+	i.Request.RequestURI = r.Redact(i.Request.RequestURI)
+	i.Request.Body = r.RedactBody(i.Request.Body, ProtocolFromContentType(i.Request.Headers.Get("Content-Type")))
+	r.redactHeaders(i.Request.Headers)
+	for k, v := range i.Request.Form {
+		for j := range v {
+			v[j] = r.RedactBody(v[j], ProtocolUnknown)
+		}
+		i.Request.Form[k] = v
+	}
+
+	i.Response.Body = r.RedactBody(i.Response.Body, ProtocolFromContentType(i.Response.Headers.Get("Content-Type")))
+	i.Response.ContentLength = int64(len(i.Response.Body))
+	r.redactHeaders(i.Response.Headers)
+
+	return nil
+}
+
+// RestoreInteraction reverses the redaction of a recorded interaction's response.
+//
+// Account ID placeholders are replaced by the configured account IDs, and secret
+// placeholders by the values whose redaction, in a request already matched by
+// this Redactor, produced them. Placeholders of values never seen in a request
+// are left unchanged.
+//
+// It has the signature of a go-vcr recorder hook.
+func (r *Redactor) RestoreInteraction(i *cassette.Interaction) error {
+	i.Response.Body = r.restore(i.Response.Body, ProtocolFromContentType(i.Response.Headers.Get("Content-Type")))
+	i.Response.ContentLength = int64(len(i.Response.Body))
+	for k, v := range i.Response.Headers {
+		for j := range v {
+			v[j] = r.restore(v[j], ProtocolUnknown)
+		}
+		i.Response.Headers[k] = v
+	}
+
+	return nil
+}
+
+func (r *Redactor) restore(s string, protocol Protocol) string {
+	if s == "" {
+		return s
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for i, re := range r.placeholderRegexps {
+		s = re.ReplaceAllString(s, "${1}"+r.accountIDs[i]+"${2}")
+	}
+
+	for placeholder, value := range r.secrets {
+		if strings.Contains(s, placeholder) {
+			s = strings.ReplaceAll(s, placeholder, escape(value, protocol))
+		}
+	}
+
+	return s
+}
+
+// placeholder returns the placeholder for a sensitive value, remembering the value for RestoreInteraction.
+func (r *Redactor) placeholder(value string) string {
+	return r.remember(value, placeholder(value))
+}
+
+// blobPlaceholder returns the placeholder for a sensitive blob value, remembering the value for RestoreInteraction.
+func (r *Redactor) blobPlaceholder(value string) string {
+	return r.remember(value, blobPlaceholder(value))
+}
+
+func (r *Redactor) remember(value, placeholder string) string {
+	if placeholder == value {
+		return placeholder
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.secrets == nil {
+		r.secrets = make(map[string]string)
+	}
+	r.secrets[placeholder] = value
+
+	return placeholder
+}
+
+// escape returns value escaped for use within a string of the specified protocol.
+func escape(value string, protocol Protocol) string {
+	switch protocol {
+	case ProtocolJSON:
+		b, err := json.Marshal(value)
+		if err != nil {
+			return value
+		}
+		return string(b[1 : len(b)-1])
+
+	case ProtocolXML:
+		var buf bytes.Buffer
+		if err := xml.EscapeText(&buf, []byte(value)); err != nil {
+			return value
+		}
+		return buf.String()
+	}
+
+	return value
+}
+
+func (r *Redactor) redactHeaders(headers http.Header) {
+	for k, v := range headers {
+		for j := range v {
+			v[j] = r.Redact(v[j])
+		}
+		headers[k] = v
+	}
+}
+
+func (r *Redactor) accountIDRule(s string, _ Protocol) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for i, re := range r.accountIDRegexps {
+		s = re.ReplaceAllString(s, "${1}"+accountIDPlaceholder(i)+"${2}")
+	}
+
+	return s
+}
+
+var accountIDRegexp = regexp.MustCompile(`^\d{12}$`)
+
+func accountIDPlaceholder(i int) string {
+	return fmt.Sprintf("%012d", i+1)
+}
+
+// placeholder returns a stable placeholder for a sensitive value.
+//
+// The placeholder embeds a short digest of the value so that distinct secrets
+// remain distinguishable and identical secrets in requests and responses
+// redact to the same placeholder. Already redacted values are returned as-is.
+func placeholder(value string) string {
+	if value == "" || strings.HasPrefix(value, placeholderPrefix) {
+		return value
+	}
+
+	sum := sha256.Sum256([]byte(value))
+
+	return placeholderPrefix + hex.EncodeToString(sum[:4])
+}
+
+const placeholderPrefix = "REDACTED-"
+
+// blobPlaceholder returns a stable, base64-encoded placeholder for a sensitive blob value.
+func blobPlaceholder(value string) string {
+	if value == "" {
+		return value
+	}
+	if b, err := base64.StdEncoding.DecodeString(value); err == nil && strings.HasPrefix(string(b), placeholderPrefix) {
+		return value
+	}
+
+	return base64.StdEncoding.EncodeToString([]byte(placeholder(value)))
+}
+
+// SecretFieldsRule returns a rule replacing the values of the named string fields.
+func SecretFieldsRule(names ...string) Rule {
+	return fieldsRule(placeholder, names...)
+}
+
+// BlobFieldsRule returns a rule replacing the values of the named base64-encoded blob fields.
+func BlobFieldsRule(names ...string) Rule {
+	return fieldsRule(blobPlaceholder, names...)
+}
+
+func fieldsRule(redact func(string) string, names ...string) Rule {
+	xmlRegexps := make([]*regexp.Regexp, 0, len(names))
+	for _, name := range names {
+		xmlRegexps = append(xmlRegexps, regexp.MustCompile(`(<`+name+`>)([^<]*)(</`+name+`>)`))
+	}
+
+	return func(s string, protocol Protocol) string {
+		switch protocol {
+		case ProtocolJSON:
+			return rewriteJSON(s, func(m map[string]any) bool {
+				var changed bool
+				for _, name := range names {
+					if v, ok := m[name].(string); ok {
+						if r := redact(v); r != v {
+							m[name], changed = r, true
+						}
+					}
+				}
+				return changed
+			})
+
+		case ProtocolXML:
+			for _, re := range xmlRegexps {
+				s = re.ReplaceAllStringFunc(s, func(match string) string {
+					parts := re.FindStringSubmatch(match)
+					return parts[1] + redact(parts[2]) + parts[3]
+				})
+			}
+			return s
+
+		case ProtocolQuery:
+			return rewriteQuery(s, func(key string, values []string) {
+				// Query protocol keys are dot-separated paths, e.g. "Parameters.member.1.Password".
+				if i := strings.LastIndex(key, "."); i >= 0 {
+					key = key[i+1:]
+				}
+				if slices.Contains(names, key) {
+					for i := range values {
+						values[i] = redact(values[i])
+					}
+				}
+			})
+		}
+
+		return s
+	}
+}
+
+// SecureStringParameterRule replaces the values of SSM SecureString parameters.
+func SecureStringParameterRule(s string, protocol Protocol) string {
+	return secureStringParameterRule(placeholder)(s, protocol)
+}
+
+func secureStringParameterRule(redact func(string) string) Rule {
+	return func(s string, protocol Protocol) string {
+		if protocol != ProtocolJSON {
+			return s
+		}
+
+		return rewriteJSON(s, func(m map[string]any) bool {
+			if m["Type"] == "SecureString" {
+				if v, ok := m["Value"].(string); ok {
+					if r := redact(v); r != v {
+						m["Value"] = r
+						return true
+					}
+				}
+			}
+			return false
+		})
+	}
+}
+
+var accessKeyIDRegexp = regexp.MustCompile(`\b(AKIA|ASIA)[A-Z0-9]{16}\b`)
+
+// AccessKeyIDRule replaces IAM access key IDs wherever they appear.
+func AccessKeyIDRule(s string, _ Protocol) string {
+	return accessKeyIDRegexp.ReplaceAllStringFunc(s, func(accessKeyID string) string {
+		if accessKeyID[4:12] == "REDACTED" {
+			return accessKeyID
+		}
+		sum := sha256.Sum256([]byte(accessKeyID))
+		return accessKeyID[:4] + "REDACTED" + strings.ToUpper(hex.EncodeToString(sum[:4]))
+	})
+}
+
+var presignedURLRegexp = regexp.MustCompile(`((?:X-Amz-Signature|X-Amz-Security-Token|Signature)=)([^&"'<\s\\]+)`)
+
+// PresignedURLRule replaces signatures and session tokens in presigned URLs.
+func PresignedURLRule(s string, _ Protocol) string {
+	return presignedURLRegexp.ReplaceAllString(s, "${1}REDACTED")
+}
+
+// rewriteJSON calls f for every JSON object in s, re-encoding s only if f reports a change.
+func rewriteJSON(s string, f func(map[string]any) bool) string {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return s
+	}
+
+	var changed bool
+	var walk func(any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			if f(v) {
+				changed = true
+			}
+			for _, e := range v {
+				walk(e)
+			}
+		case []any:
+			for _, e := range v {
+				walk(e)
+			}
+		}
+	}
+	walk(v)
+
+	if !changed {
+		return s
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return s
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// rewriteQuery calls f for every key in the form-encoded s, re-encoding s only if f changed a value.
+func rewriteQuery(s string, f func(string, []string)) string {
+	values, err := url.ParseQuery(s)
+	if err != nil {
+		return s
+	}
+
+	var changed bool
+	for k, v := range values {
+		before := slices.Clone(v)
+		f(k, v)
+		if !slices.Equal(before, v) {
+			changed = true
+		}
+	}
+
+	if !changed {
+		return s
+	}
+
+	return values.Encode()
+}
@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+// NetworkDisabledTransport is an http.RoundTripper that refuses all requests
+//
+// It is used as the real transport of a VCR recorder in REPLAY_OFFLINE mode so that
+// no request can ever reach the network, whatever the recorder mode.
+var NetworkDisabledTransport http.RoundTripper = networkDisabledTransport{}
+
+type networkDisabledTransport struct{}
+
+func (networkDisabledTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	return nil, fmt.Errorf("network access is disabled in %s mode: %s %s", vcrModeReplayOffline, r.Method, r.URL)
+}
+
+type replayTransport struct {
+	next         http.RoundTripper
+	matcher      cassette.MatcherFunc
+	interactions []*cassette.Interaction
+}
+
+// NewReplayTransport wraps a replaying VCR recorder to make replay of repeated
+// requests deterministic and failures self-explanatory.
+//
+// Identical requests are matched to recorded interactions in recording order, so
+// the Nth poll in a polling loop receives the Nth recorded response. Once every
+// matching interaction of a read operation (see isReadOperation) has been replayed,
+// the last one is replayed again, so a loop that polls more often than when it was
+// recorded observes the final recorded state. A repeated mutating request, or a
+// request with no matching interaction at all, fails immediately with a diff
+// against the closest recorded interaction.
+func NewReplayTransport(next http.RoundTripper, cassetteName string, matcher cassette.MatcherFunc) (http.RoundTripper, error) {
+	c, err := cassette.Load(cassetteName)
+	if err != nil {
+		return nil, fmt.Errorf("loading VCR cassette (%s): %w", cassetteName, err)
+	}
+
+	return &replayTransport{
+		next:         next,
+		matcher:      matcher,
+		interactions: c.Interactions,
+	}, nil
+}
+
+func (t *replayTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := t.next.RoundTrip(r)
+	if err == nil || !errors.Is(err, cassette.ErrInteractionNotFound) {
+		return resp, err
+	}
+
+	// All matching interactions have been replayed. Replay the last one again if the request only reads.
+	if isReadOperation(r, string(body)) {
+		for _, i := range slices.Backward(t.interactions) {
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			if t.matcher(r, i.Request) {
+				resp, err := i.GetHTTPResponse()
+				if err != nil {
+					return nil, err
+				}
+				resp.Request = r
+				return resp, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%w in %s mode: %s %s\n%s", err, os.Getenv(envVarVCRMode), r.Method, r.URL, closestInteractionDiff(r, string(body), t.interactions))
+}
+
+// readOperationPrefixes are the name prefixes of AWS API operations that only read, and so may be polled.
+var readOperationPrefixes = []string{
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+}
+
+// isReadOperation returns whether a request only reads, i.e. is a GET or HEAD request
+// or targets a JSON or query protocol operation named as a read.
+func isReadOperation(r *http.Request, body string) bool {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return true
+	}
+
+	// JSON protocol targets are of the form "<service>.<operation>", e.g. "AmazonSSM.GetParameter".
+	op := operation(r.Header, body)
+	if i := strings.LastIndex(op, "."); i >= 0 {
+		op = op[i+1:]
+	}
+
+	return op != "" && slices.ContainsFunc(readOperationPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(op, prefix)
+	})
+}
+
+// interactionSummary is the subset of a request compared when reporting the closest recorded interaction.
+type interactionSummary struct {
+	Method    string
+	URL       string
+	Operation string
+	Body      string
+}
+
+func closestInteractionDiff(r *http.Request, body string, interactions []*cassette.Interaction) string {
+	want := interactionSummary{
+		Method:    r.Method,
+		URL:       r.URL.String(),
+		Operation: operation(r.Header, body),
+		Body:      body,
+	}
+
+	var (
+		closest   *cassette.Interaction
+		bestScore = -1
+	)
+	for _, i := range interactions {
+		if score := interactionScore(want, i.Request); score > bestScore {
+			closest, bestScore = i, score
+		}
+	}
+
+	if closest == nil {
+		return "cassette contains no recorded interactions"
+	}
+
+	got := interactionSummary{
+		Method:    closest.Request.Method,
+		URL:       closest.Request.URL,
+		Operation: operation(closest.Request.Headers, closest.Request.Body),
+		Body:      closest.Request.Body,
+	}
+
+	return fmt.Sprintf("closest recorded interaction (id %d) differs (-recorded +request):\n%s", closest.ID, cmp.Diff(got, want))
+}
+
+// interactionScore ranks how closely a recorded request resembles the request being replayed.
+func interactionScore(want interactionSummary, got cassette.Request) int {
+	var score int
+
+	if want.Method == got.Method {
+		score++
+	}
+	if want.URL == got.URL {
+		score += 2
+	}
+	if want.Operation != "" && want.Operation == operation(got.Headers, got.Body) {
+		score += 4
+	}
+	if want.Body == got.Body {
+		score++
+	}
+
+	return score
+}
+
+// operation returns the AWS API operation targeted by a JSON (X-Amz-Target header) or query (Action parameter) protocol request.
+func operation(header http.Header, body string) string {
+	if v := header.Get("X-Amz-Target"); v != "" {
+		return v
+	}
+
+	if values, err := url.ParseQuery(body); err == nil {
+		return values.Get("Action")
+	}
+
+	return ""
+}
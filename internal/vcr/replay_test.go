@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+func TestReplayTransport(t *testing.T) {
+	t.Parallel()
+
+	const (
+		endpoint    = "https://ec2.us-west-2.amazonaws.com/"
+		contentType = "application/x-www-form-urlencoded"
+		describeVPC = "Action=DescribeVpcs&VpcId.1=vpc-12345678&Version=2016-11-15"
+		deleteVPC   = "Action=DeleteVpc&VpcId=vpc-12345678&Version=2016-11-15"
+	)
+
+	cassetteName := filepath.Join(t.TempDir(), "TestReplayTransport")
+
+	// Record a polling loop against a stub transport, followed by an unrelated request.
+	states := []string{"pending", "available", "subnet", "deleted"}
+	rec, err := recorder.New(cassetteName,
+		recorder.WithMode(recorder.ModeRecordOnly),
+		recorder.WithRealTransport(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			if _, err := io.Copy(io.Discard, r.Body); err != nil {
+				return nil, err
+			}
+			state := states[0]
+			states = states[1:]
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(state)),
+				Request:    r,
+			}, nil
+		})),
+		recorder.WithSkipRequestLatency(true),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, body := range []string{
+		describeVPC,
+		describeVPC,
+		"Action=DescribeSubnets&SubnetId.1=subnet-12345678&Version=2016-11-15",
+		deleteVPC,
+	} {
+		resp, err := rec.GetDefaultClient().Post(endpoint, contentType, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := recorder.New(cassetteName,
+		recorder.WithMode(recorder.ModeReplayOnly),
+		recorder.WithRealTransport(vcr.NetworkDisabledTransport),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transport, err := vcr.NewReplayTransport(r, cassetteName, cassette.DefaultMatcher)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &http.Client{Transport: transport}
+
+	// Polling more often than recorded replays the final recorded state.
+	for i, want := range []string{"pending", "available", "available"} {
+		resp, err := client.Post(endpoint, contentType, strings.NewReader(describeVPC))
+		if err != nil {
+			t.Fatalf("poll %d: unexpected error: %s", i, err)
+		}
+		got, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("poll %d: got %q, want %q", i, got, want)
+		}
+	}
+
+	_, err = client.Post(endpoint, contentType, strings.NewReader("Action=DescribeSubnets&SubnetId.1=subnet-87654321&Version=2016-11-15"))
+	if err == nil {
+		t.Fatal("unrecorded interaction: expected error, got none")
+	}
+
+	for _, want := range []string{"requested interaction not found", "closest recorded interaction (id 2)", "87654321"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("unrecorded interaction: error %q does not contain %q", err, want)
+		}
+	}
+
+	// A mutating request is not replayed more often than recorded.
+	for i := range 2 {
+		resp, err := client.Post(endpoint, contentType, strings.NewReader(deleteVPC))
+		if i == 0 {
+			if err != nil {
+				t.Fatalf("delete: unexpected error: %s", err)
+			}
+			resp.Body.Close()
+		} else if err == nil {
+			t.Fatal("repeated delete: expected error, got none")
+		}
+	}
+}
+
+func TestIdentityFile(t *testing.T) {
+	t.Parallel()
+
+	fileName := filepath.Join(t.TempDir(), "TestIdentityFile.identity.json")
+	want := &vcr.Identity{
+		AccountID: "123456789012",
+		Partition: "aws",
+		Region:    "us-west-2",
+	}
+
+	if err := vcr.WriteIdentityToFile(want, fileName); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := vcr.ReadIdentityFromFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *got != *want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -304,3 +304,7 @@ const (
 
 	topLevelRegionDefaultDescription = `Defaults to the Region set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
 )
+
+const (
+	ResourceTopLevelAssumeRoleARNAttributeDescription = `ARN of an IAM role to assume when managing this resource, e.g. to manage the resource in another AWS account. Defaults to the credentials set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
+)
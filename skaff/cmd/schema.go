@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/schema"
+	"github.com/spf13/cobra"
+)
+
+var (
+	createInput string
+	readOutput  string
+)
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Create a framework schema and model from AWS SDK types",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return schema.Create(name, snakeName, createInput, readOutput, force)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(schemaCmd)
+	schemaCmd.Flags().StringVarP(&snakeName, "snakename", "s", "", "if skaff doesn't get it right, explicitly give name in snake case (e.g., db_vpc_instance)")
+	schemaCmd.Flags().StringVarP(&name, "name", "n", "", "name of the entity")
+	schemaCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+	schemaCmd.Flags().StringVarP(&createInput, "create-input", "i", "", "AWS SDK input type of the Create operation (default Create<name>Input)")
+	schemaCmd.Flags().StringVarP(&readOutput, "read-output", "o", "", "AWS SDK output type of the Read operation (e.g., DescribeDBInstanceOutput)")
+}
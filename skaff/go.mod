@@ -6,6 +6,7 @@ require (
 	github.com/YakDriver/regexache v0.25.0
 	github.com/hashicorp/terraform-provider-aws v1.60.1-0.20220322001452-8f7a597d0c24
 	github.com/spf13/cobra v1.10.2
+	golang.org/x/tools v0.48.0
 )
 
 require (
@@ -22,7 +23,6 @@ require (
 	golang.org/x/mod v0.38.0 // indirect
 	golang.org/x/sync v0.22.0 // indirect
 	golang.org/x/text v0.40.0 // indirect
-	golang.org/x/tools v0.48.0 // indirect
 )
 
 replace github.com/hashicorp/terraform-provider-aws => ../
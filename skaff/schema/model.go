@@ -0,0 +1,699 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"cmp"
+	"fmt"
+	"go/types"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+// Model is a Terraform Plugin Framework model struct and its schema.
+type Model struct {
+	Name   string
+	Fields []*Field
+	// UnionMembers lists the AWS SDK union member types of a union model, e.g. `awstypes.ConfigurationMemberS3`.
+	UnionMembers []string
+}
+
+func (m *Model) Attributes() []*Field {
+	return slices.DeleteFunc(slices.Clone(m.Fields), func(f *Field) bool { return f.IsBlock })
+}
+
+func (m *Model) Blocks() []*Field {
+	return slices.DeleteFunc(slices.Clone(m.Fields), func(f *Field) bool { return !f.IsBlock })
+}
+
+// Field is a model struct field and its schema attribute or block.
+type Field struct {
+	Name      string // Model struct field name
+	TFName    string // Attribute name, e.g. `names.AttrName` or `"foo_bar"`
+	Tag       string // `tfsdk` tag value
+	ModelType string
+
+	// Expr is a complete schema expression, used instead of the other schema fields when set.
+	Expr string
+
+	IsBlock      bool
+	SchemaType   string
+	CustomType   string
+	ElementType  string
+	Required     bool
+	Optional     bool
+	Computed     bool
+	PlanModifier string // Kind of plan modifier, e.g. `String`
+	Validator    string // Kind of validator, e.g. `List`
+	Validators   []string
+	Nested       *Model
+}
+
+// PlanModifierPackage returns the name of the plan modifier package for the field.
+func (f *Field) PlanModifierPackage() string {
+	return strings.ToLower(f.PlanModifier) + "planmodifier"
+}
+
+// ObjectValidators returns the validators for the nested object of a union block.
+func (f *Field) ObjectValidators() []string {
+	if f.Nested == nil || len(f.Nested.UnionMembers) == 0 || f.Computed {
+		return nil
+	}
+
+	var expressions []string
+	for _, field := range f.Nested.Fields {
+		expressions = append(expressions, fmt.Sprintf("path.MatchRelative().AtName(%s)", field.TFName))
+	}
+
+	return []string{fmt.Sprintf("tfobjectvalidator.ExactlyOneOfChildren(\n%s,\n)", strings.Join(expressions, ",\n"))}
+}
+
+// ignoredFieldNames are AWS API fields that are never part of a resource schema.
+var ignoredFieldNames = []string{
+	"ClientToken",
+	"DryRun",
+	"MaxResults",
+	"NextToken",
+	"ResultMetadata",
+}
+
+const (
+	requiredMemberComment = "This member is required."
+	tagsFieldName         = "Tags"
+)
+
+// builder derives Terraform Plugin Framework models from AWS SDK for Go v2 types.
+type builder struct {
+	// isRequired reports whether an AWS SDK struct field is documented as required.
+	isRequired func(*types.Var) bool
+	// typesPkgPath is the import path of the AWS SDK service's `types` package.
+	typesPkgPath string
+
+	models     map[string]*Model
+	inProgress map[string]bool
+	imports    map[string]string // Import path -> alias.
+	skipped    []string
+	hasTags    bool
+}
+
+func newBuilder(typesPkgPath string, isRequired func(*types.Var) bool) *builder {
+	return &builder{
+		isRequired:   isRequired,
+		typesPkgPath: typesPkgPath,
+		models:       make(map[string]*Model),
+		inProgress:   make(map[string]bool),
+		imports:      make(map[string]string),
+	}
+}
+
+func (b *builder) addImport(path, alias string) {
+	b.imports[path] = alias
+}
+
+// resourceModel returns the top-level resource model for the specified Create input and Read output.
+func (b *builder) resourceModel(name string, input, output *types.Struct) *Model {
+	model := &Model{
+		Name: name,
+	}
+
+	model.Fields = b.fields(name, input, output, input == nil)
+
+	if b.hasTags {
+		b.addImport("github.com/hashicorp/terraform-provider-aws/internal/tags", "tftags")
+		model.Fields = append(model.Fields,
+			&Field{
+				Name:      "Tags",
+				TFName:    "names.AttrTags",
+				Tag:       names.AttrTags,
+				ModelType: "tftags.Map",
+				Expr:      "tftags.TagsAttribute()",
+			},
+			&Field{
+				Name:      "TagsAll",
+				TFName:    "names.AttrTagsAll",
+				Tag:       names.AttrTagsAll,
+				ModelType: "tftags.Map",
+				Expr:      "tftags.TagsAttributeComputedOnly()",
+			},
+		)
+		sortFields(model.Fields)
+	}
+
+	return model
+}
+
+// Models returns all nested models, sorted by name.
+func (b *builder) Models() []*Model {
+	models := slices.Collect(func(yield func(*Model) bool) {
+		for _, m := range b.models {
+			if !yield(m) {
+				return
+			}
+		}
+	})
+	slices.SortFunc(models, func(a, b *Model) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return models
+}
+
+// fields merges the fields of an input struct and an output struct.
+// Fields only present in the output are Computed.
+func (b *builder) fields(parent string, input, output *types.Struct, computedOnly bool) []*Field {
+	var fieldNames []string
+	inputFields := make(map[string]*types.Var)
+	outputFields := make(map[string]*types.Var)
+
+	for v := range structFields(input) {
+		inputFields[v.Name()] = v
+		fieldNames = append(fieldNames, v.Name())
+	}
+	for v := range structFields(output) {
+		outputFields[v.Name()] = v
+		if _, ok := inputFields[v.Name()]; !ok {
+			fieldNames = append(fieldNames, v.Name())
+		}
+	}
+
+	var fields []*Field
+	for _, name := range fieldNames {
+		if slices.Contains(ignoredFieldNames, name) {
+			continue
+		}
+
+		in, out := inputFields[name], outputFields[name]
+
+		if name == tagsFieldName {
+			b.hasTags = true
+			continue
+		}
+
+		field := &Field{
+			Name: fieldName(name),
+			Tag:  names.ToSnakeCase(name),
+		}
+		field.TFName = namesgen.ConstOrQuote(field.Tag)
+
+		switch {
+		case computedOnly || in == nil:
+			field.Computed = true
+		case b.isRequired(in):
+			field.Required = true
+		case out != nil:
+			field.Optional = true
+			field.Computed = true
+		default:
+			field.Optional = true
+		}
+
+		if name == "Arn" && field.Computed && !field.Optional {
+			b.addImport("github.com/hashicorp/terraform-provider-aws/internal/framework", "")
+			field.Name = "ARN"
+			field.ModelType = "types.String"
+			field.Expr = "framework.ARNAttributeComputedOnly()"
+			fields = append(fields, field)
+			continue
+		}
+
+		var typeIn, typeOut types.Type
+		if in != nil {
+			typeIn = in.Type()
+		}
+		if out != nil {
+			typeOut = out.Type()
+		}
+
+		if !b.mapField(field, typeIn, typeOut) {
+			b.skipped = append(b.skipped, fmt.Sprintf("%s.%s (%s)", parent, name, types.TypeString(cmp.Or(typeIn, typeOut), (*types.Package).Name)))
+			continue
+		}
+
+		fields = append(fields, field)
+	}
+
+	sortFields(fields)
+
+	return fields
+}
+
+// mapField sets the model type and schema of a field from its AWS SDK types.
+// typeOut is only used to merge the fields of nested structures that are in both the input and the output.
+func (b *builder) mapField(field *Field, typeIn, typeOut types.Type) bool {
+	typ := derefType(cmp.Or(typeIn, typeOut))
+	if typeIn == nil {
+		typeOut = nil
+	}
+
+	if alias, ok := typ.(*types.Alias); ok {
+		// Smithy documents are aliases of an internal package's type.
+		if obj := alias.Obj(); isDocument(obj) {
+			b.addImport(obj.Pkg().Path(), "")
+			field.ModelType = "fwtypes.SmithyJSON[document.Interface]"
+			field.SchemaType = "schema.StringAttribute"
+			field.CustomType = "fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument)"
+			field.setPlanModifier("String")
+			return true
+		}
+		typ = types.Unalias(typ)
+	}
+
+	switch typ := typ.(type) {
+	case *types.Basic:
+		return b.mapBasic(field, typ)
+
+	case *types.Named:
+		obj := typ.Obj()
+		switch {
+		case obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time":
+			b.addImport("github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes", "")
+			field.ModelType = "timetypes.RFC3339"
+			field.SchemaType = "schema.StringAttribute"
+			field.CustomType = "timetypes.RFC3339Type{}"
+			field.setPlanModifier("String")
+			return true
+
+		case isEnum(typ):
+			enum := b.qualifiedName(typ)
+			field.ModelType = fmt.Sprintf("fwtypes.StringEnum[%s]", enum)
+			field.SchemaType = "schema.StringAttribute"
+			field.CustomType = fmt.Sprintf("fwtypes.StringEnumType[%s]()", enum)
+			field.setPlanModifier("String")
+			return true
+		}
+
+		switch underlying := typ.Underlying().(type) {
+		case *types.Basic:
+			return b.mapBasic(field, underlying)
+
+		case *types.Struct:
+			var structOut *types.Struct
+			if named, ok := derefType(typeOut).(*types.Named); ok {
+				structOut, _ = named.Underlying().(*types.Struct)
+			}
+			model, ok := b.structModel(typ, underlying, structOut, field.Computed && !field.Optional)
+			if !ok {
+				return false
+			}
+			b.setNested(field, model, false)
+			return true
+
+		case *types.Interface:
+			model, ok := b.unionModel(typ, field.Computed && !field.Optional)
+			if !ok {
+				return false
+			}
+			b.setNested(field, model, false)
+			return true
+		}
+
+	case *types.Slice:
+		return b.mapSlice(field, typ, typeOut)
+
+	case *types.Map:
+		if isString(typ.Key()) && isString(derefType(typ.Elem())) {
+			field.ModelType = "fwtypes.MapOfString"
+			field.SchemaType = "schema.MapAttribute"
+			field.CustomType = "fwtypes.MapOfStringType"
+			field.ElementType = "types.StringType"
+			field.setPlanModifier("Map")
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *builder) mapBasic(field *Field, typ *types.Basic) bool {
+	var kind string
+	switch typ.Kind() {
+	case types.String:
+		kind = "String"
+	case types.Bool:
+		kind = "Bool"
+	case types.Int32:
+		kind = "Int32"
+	case types.Int, types.Int64:
+		kind = "Int64"
+	case types.Float32:
+		kind = "Float32"
+	case types.Float64:
+		kind = "Float64"
+	default:
+		return false
+	}
+
+	field.ModelType = "types." + kind
+	field.SchemaType = fmt.Sprintf("schema.%sAttribute", kind)
+	field.setPlanModifier(kind)
+
+	return true
+}
+
+func (b *builder) mapSlice(field *Field, typ *types.Slice, typeOut types.Type) bool {
+	elem := derefType(typ.Elem())
+
+	if basic, ok := elem.(*types.Basic); ok && basic.Kind() == types.Byte {
+		// Blobs are flexed as strings.
+		field.ModelType = "types.String"
+		field.SchemaType = "schema.StringAttribute"
+		field.setPlanModifier("String")
+		return true
+	}
+
+	field.SchemaType = "schema.ListAttribute"
+	field.setPlanModifier("List")
+
+	switch elem := elem.(type) {
+	case *types.Basic:
+		switch elem.Kind() {
+		case types.String:
+			field.ModelType = "fwtypes.ListOfString"
+			field.CustomType = "fwtypes.ListOfStringType"
+			field.ElementType = "types.StringType"
+			return true
+		case types.Int32, types.Int64:
+			field.ModelType = "fwtypes.ListOfInt64"
+			field.CustomType = "fwtypes.ListOfInt64Type"
+			field.ElementType = "types.Int64Type"
+			return true
+		}
+
+	case *types.Named:
+		if isEnum(elem) {
+			enum := b.qualifiedName(elem)
+			field.ModelType = fmt.Sprintf("fwtypes.ListOfStringEnum[%s]", enum)
+			field.CustomType = fmt.Sprintf("fwtypes.ListOfStringEnumType[%s]()", enum)
+			field.ElementType = fmt.Sprintf("fwtypes.StringEnumType[%s]()", enum)
+			return true
+		}
+
+		switch underlying := elem.Underlying().(type) {
+		case *types.Struct:
+			var structOut *types.Struct
+			if slice, ok := derefType(typeOut).(*types.Slice); ok {
+				if named, ok := derefType(slice.Elem()).(*types.Named); ok {
+					structOut, _ = named.Underlying().(*types.Struct)
+				}
+			}
+			model, ok := b.structModel(elem, underlying, structOut, field.Computed && !field.Optional)
+			if !ok {
+				return false
+			}
+			b.setNested(field, model, true)
+			return true
+
+		case *types.Interface:
+			model, ok := b.unionModel(elem, field.Computed && !field.Optional)
+			if !ok {
+				return false
+			}
+			b.setNested(field, model, true)
+			return true
+		}
+	}
+
+	return false
+}
+
+// setNested sets the schema of a field whose value is a nested model.
+// Configurable values are nested blocks, Computed-only values are nested attributes.
+func (b *builder) setNested(field *Field, model *Model, isList bool) {
+	field.Nested = model
+	field.ModelType = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", model.Name)
+	field.CustomType = fmt.Sprintf("fwtypes.NewListNestedObjectTypeOf[%s](ctx)", model.Name)
+	field.PlanModifier = ""
+
+	if field.Computed && !field.Optional {
+		field.SchemaType = "schema.ListAttribute"
+		field.ElementType = fmt.Sprintf("fwtypes.NewObjectTypeOf[%s](ctx)", model.Name)
+		field.setPlanModifier("List")
+		return
+	}
+
+	// Blocks cannot be Computed.
+	field.IsBlock = true
+	field.SchemaType = "schema.ListNestedBlock"
+	field.ElementType = ""
+	field.Computed = false
+	field.Validator = "List"
+	switch {
+	case isList && field.Required:
+		field.Validators = append(field.Validators, "listvalidator.SizeAtLeast(1)")
+	case !isList && field.Required:
+		field.Validators = append(field.Validators, "listvalidator.IsRequired()", "listvalidator.SizeAtMost(1)")
+	case !isList:
+		field.Validators = append(field.Validators, "listvalidator.SizeAtMost(1)")
+	}
+	if len(field.Validators) > 0 {
+		b.addImport("github.com/hashicorp/terraform-plugin-framework-validators/listvalidator", "")
+	}
+	field.Required, field.Optional = false, false
+}
+
+// structModel returns the model for an AWS SDK structure.
+func (b *builder) structModel(named *types.Named, input, output *types.Struct, computedOnly bool) (*Model, bool) {
+	name := modelName(named)
+
+	if model, ok := b.models[name]; ok {
+		return model, true
+	}
+	if b.inProgress[name] {
+		// Recursive structures can't be represented in a schema.
+		return nil, false
+	}
+
+	b.inProgress[name] = true
+	defer delete(b.inProgress, name)
+
+	if computedOnly {
+		input, output = nil, input
+	}
+
+	model := &Model{
+		Name: name,
+	}
+	model.Fields = b.fields(named.Obj().Name(), input, output, computedOnly)
+	b.models[name] = model
+
+	return model, true
+}
+
+// unionModel returns the model for an AWS SDK union interface.
+// Union members are the types in the same package named `<Union>Member<Member>`.
+func (b *builder) unionModel(named *types.Named, computedOnly bool) (*Model, bool) {
+	obj := named.Obj()
+	if obj.Pkg() == nil {
+		return nil, false
+	}
+
+	name := modelName(named)
+
+	if model, ok := b.models[name]; ok {
+		return model, true
+	}
+	if b.inProgress[name] {
+		return nil, false
+	}
+
+	b.inProgress[name] = true
+	defer delete(b.inProgress, name)
+
+	model := &Model{
+		Name: name,
+	}
+
+	prefix := obj.Name() + "Member"
+	scope := obj.Pkg().Scope()
+	for _, memberName := range scope.Names() {
+		member, ok := strings.CutPrefix(memberName, prefix)
+		if !ok || member == "" {
+			continue
+		}
+		memberType, ok := scope.Lookup(memberName).Type().(*types.Named)
+		if !ok {
+			continue
+		}
+		memberStruct, ok := memberType.Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+		var value *types.Var
+		for v := range structFields(memberStruct) {
+			if v.Name() == "Value" {
+				value = v
+			}
+		}
+		if value == nil {
+			continue
+		}
+
+		field := &Field{
+			Name: fieldName(member),
+			Tag:  names.ToSnakeCase(member),
+		}
+		field.TFName = namesgen.ConstOrQuote(field.Tag)
+		if computedOnly {
+			field.Computed = true
+		} else {
+			field.Optional = true
+		}
+
+		if !b.mapField(field, value.Type(), nil) {
+			b.skipped = append(b.skipped, fmt.Sprintf("%s.%s (%s)", obj.Name(), member, types.TypeString(value.Type(), (*types.Package).Name)))
+			continue
+		}
+
+		model.Fields = append(model.Fields, field)
+		model.UnionMembers = append(model.UnionMembers, b.qualifiedName(memberType))
+	}
+
+	if len(model.Fields) == 0 {
+		return nil, false
+	}
+
+	sortFields(model.Fields)
+	b.addImport("github.com/hashicorp/terraform-plugin-framework/path", "")
+	b.addImport("github.com/hashicorp/terraform-provider-aws/internal/framework/validators/objectvalidator", "tfobjectvalidator")
+	b.models[name] = model
+
+	return model, true
+}
+
+// qualifiedName returns the name of an AWS SDK type qualified by its package alias.
+func (b *builder) qualifiedName(named *types.Named) string {
+	obj := named.Obj()
+	if obj.Pkg() != nil && obj.Pkg().Path() == b.typesPkgPath {
+		b.addImport(b.typesPkgPath, "awstypes")
+		return "awstypes." + obj.Name()
+	}
+
+	b.addImport(obj.Pkg().Path(), "")
+	return obj.Pkg().Name() + "." + obj.Name()
+}
+
+func (f *Field) setPlanModifier(kind string) {
+	if f.Computed {
+		f.PlanModifier = kind
+	}
+}
+
+func sortFields(fields []*Field) {
+	slices.SortFunc(fields, func(a, b *Field) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+}
+
+func structFields(s *types.Struct) func(func(*types.Var) bool) {
+	return func(yield func(*types.Var) bool) {
+		if s == nil {
+			return
+		}
+		for v := range s.Fields() {
+			if !v.Exported() || v.Embedded() {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func derefType(t types.Type) types.Type {
+	if t == nil {
+		return nil
+	}
+	if ptr, ok := t.(*types.Pointer); ok {
+		return ptr.Elem()
+	}
+	return t
+}
+
+func isString(t types.Type) bool {
+	basic, ok := t.(*types.Basic)
+	return ok && basic.Kind() == types.String
+}
+
+// isEnum returns whether the type is an AWS SDK enum, a string type with a `Values` method.
+func isEnum(named *types.Named) bool {
+	if basic, ok := named.Underlying().(*types.Basic); !ok || basic.Kind() != types.String {
+		return false
+	}
+
+	return types.NewMethodSet(named).Lookup(named.Obj().Pkg(), "Values") != nil
+}
+
+// isDocument returns whether the type is a Smithy document, `document.Interface`.
+func isDocument(obj *types.TypeName) bool {
+	return obj.Pkg() != nil && obj.Pkg().Name() == "document" && obj.Name() == "Interface"
+}
+
+func modelName(named *types.Named) string {
+	return convert.ToLowercasePrefix(named.Obj().Name()) + "Model"
+}
+
+// initialisms are capitalized in model field names, following the provider's naming conventions.
+var initialisms = []string{
+	"Acl",
+	"Acm",
+	"Ami",
+	"Api",
+	"Arn",
+	"Cidr",
+	"Dns",
+	"Ebs",
+	"Ec2",
+	"Ecr",
+	"Ecs",
+	"Http",
+	"Https",
+	"Iam",
+	"Id",
+	"Ip",
+	"Json",
+	"Kms",
+	"Sns",
+	"Sql",
+	"Sqs",
+	"Ssh",
+	"Ssl",
+	"Ssm",
+	"Tls",
+	"Ttl",
+	"Uri",
+	"Url",
+	"Vpc",
+}
+
+// fieldName returns the model field name for an AWS SDK field name.
+// AutoFlex matches field names case-insensitively, so initialisms can be capitalized.
+func fieldName(awsName string) string {
+	var words []string
+	start := 0
+	for i := 1; i <= len(awsName); i++ {
+		if i == len(awsName) || (awsName[i] >= 'A' && awsName[i] <= 'Z') {
+			words = append(words, awsName[start:i])
+			start = i
+		}
+	}
+
+	var sb strings.Builder
+	for _, word := range words {
+		switch singular, plural := strings.CutSuffix(word, "s"); {
+		case slices.Contains(initialisms, word):
+			sb.WriteString(strings.ToUpper(word))
+		case plural && slices.Contains(initialisms, singular):
+			sb.WriteString(strings.ToUpper(singular) + "s")
+		default:
+			sb.WriteString(word)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,288 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"golang.org/x/tools/go/packages"
+)
+
+//go:embed schema.gtpl
+var schemaTmpl string
+
+type TemplateData struct {
+	ServicePackage     string
+	ResourceLowerCamel string
+	StdlibImports      []string
+	Imports            []string
+	Model              *Model
+	Models             []*Model
+	Skipped            []string
+}
+
+// Create writes a Terraform Plugin Framework schema and model structs derived from
+// an AWS SDK for Go v2 Create operation input type and Read operation output type.
+func Create(resName, snakeName, createInput, readOutput string, force bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if resName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	if snakeName == "" {
+		snakeName = names.ToSnakeCase(resName)
+	}
+
+	if createInput == "" {
+		createInput = fmt.Sprintf("Create%sInput", convert.ToAWSCapitalization(resName))
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	sdkPkgPath := "github.com/aws/aws-sdk-go-v2/service/" + service.GoV2Package()
+	typesPkgPath := sdkPkgPath + "/types"
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+		Fset: token.NewFileSet(),
+	}
+	pkgs, err := packages.Load(cfg, sdkPkgPath, typesPkgPath)
+	if err != nil {
+		return fmt.Errorf("error loading AWS SDK packages: %w", err)
+	}
+
+	var files []*ast.File
+	pkgsByPath := make(map[string]*types.Package)
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return fmt.Errorf("error loading AWS SDK package (%s): %s", pkg.PkgPath, pkg.Errors[0])
+		}
+		files = append(files, pkg.Syntax...)
+		pkgsByPath[pkg.PkgPath] = pkg.Types
+	}
+
+	input, err := lookupStruct(pkgsByPath[sdkPkgPath], pkgsByPath[typesPkgPath], createInput)
+	if err != nil {
+		return err
+	}
+
+	var output *types.Struct
+	if readOutput != "" {
+		if output, err = lookupStruct(pkgsByPath[sdkPkgPath], pkgsByPath[typesPkgPath], readOutput); err != nil {
+			return err
+		}
+		output = unwrapOutput(output)
+	}
+
+	templateData := newTemplateData(servicePackage, convert.ToLowercasePrefix(resName), typesPkgPath, input, output, requiredFields(files))
+
+	f := fmt.Sprintf("%s_schema.go", snakeName)
+	if err = writeTemplate("schema", f, force, templateData); err != nil {
+		return fmt.Errorf("writing schema template: %w", err)
+	}
+
+	return nil
+}
+
+func newTemplateData(servicePackage, resLowerCamel, typesPkgPath string, input, output *types.Struct, isRequired func(*types.Var) bool) TemplateData {
+	b := newBuilder(typesPkgPath, isRequired)
+	model := b.resourceModel(resLowerCamel+"ResourceModel", input, output)
+	models := b.Models()
+
+	b.addImport("github.com/hashicorp/terraform-plugin-framework/resource", "")
+	b.addImport("github.com/hashicorp/terraform-plugin-framework/resource/schema", "")
+	b.addImport("github.com/hashicorp/terraform-plugin-framework/types", "")
+	b.addImport("github.com/hashicorp/terraform-provider-aws/internal/framework", "")
+	for _, field := range allFields(append([]*Model{model}, models...)) {
+		if strings.Contains(field.ModelType+field.CustomType+field.ElementType, "fwtypes.") {
+			b.addImport("github.com/hashicorp/terraform-provider-aws/internal/framework/types", "fwtypes")
+		}
+		if strings.HasPrefix(field.TFName, "names.") {
+			b.addImport("github.com/hashicorp/terraform-provider-aws/names", "")
+		}
+		if field.PlanModifier != "" {
+			b.addImport("github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier", "")
+			b.addImport("github.com/hashicorp/terraform-plugin-framework/resource/schema/"+field.PlanModifierPackage(), "")
+		}
+		if len(field.Validators) > 0 || len(field.ObjectValidators()) > 0 {
+			b.addImport("github.com/hashicorp/terraform-plugin-framework/schema/validator", "")
+		}
+	}
+
+	var imports []string
+	for path, alias := range b.imports {
+		if alias != "" {
+			imports = append(imports, fmt.Sprintf("%s %q", alias, path))
+		} else {
+			imports = append(imports, fmt.Sprintf("%q", path))
+		}
+	}
+	slices.SortFunc(imports, func(a, b string) int {
+		return strings.Compare(importPath(a), importPath(b))
+	})
+
+	return TemplateData{
+		ServicePackage:     servicePackage,
+		ResourceLowerCamel: resLowerCamel,
+		StdlibImports:      []string{`"context"`},
+		Imports:            imports,
+		Model:              model,
+		Models:             models,
+		Skipped:            b.skipped,
+	}
+}
+
+// lookupStruct returns the named AWS SDK structure.
+// Names qualified with `types.` are looked up in the service's `types` package.
+func lookupStruct(sdkPkg, typesPkg *types.Package, name string) (*types.Struct, error) {
+	pkg := sdkPkg
+	if v, ok := strings.CutPrefix(name, "types."); ok {
+		pkg, name = typesPkg, v
+	}
+
+	if pkg == nil {
+		return nil, fmt.Errorf("error looking up AWS SDK type (%s): package not loaded", name)
+	}
+
+	obj := pkg.Scope().Lookup(name)
+	if obj == nil {
+		return nil, fmt.Errorf("error looking up AWS SDK type (%s): not found in package %s", name, pkg.Path())
+	}
+
+	s, ok := obj.Type().Underlying().(*types.Struct)
+	if !ok {
+		return nil, fmt.Errorf("error looking up AWS SDK type (%s): not a structure", name)
+	}
+
+	return s, nil
+}
+
+// unwrapOutput returns the structure wrapped by a Read operation output, e.g. `DescribeWidgetOutput.Widget`.
+// The output is returned unchanged if it doesn't have exactly one structure-valued field.
+func unwrapOutput(output *types.Struct) *types.Struct {
+	var wrapped []*types.Struct
+	var n int
+	for v := range structFields(output) {
+		if v.Name() == "ResultMetadata" {
+			continue
+		}
+		n++
+		if s, ok := derefType(v.Type()).Underlying().(*types.Struct); ok {
+			wrapped = append(wrapped, s)
+		}
+	}
+
+	if n == 1 && len(wrapped) == 1 {
+		return wrapped[0]
+	}
+
+	return output
+}
+
+// requiredFields returns a function that reports whether an AWS SDK structure field
+// is documented as required.
+func requiredFields(files []*ast.File) func(*types.Var) bool {
+	required := make(map[token.Pos]bool)
+	for _, file := range files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			field, ok := n.(*ast.Field)
+			if !ok || field.Doc == nil {
+				return true
+			}
+			if strings.Contains(field.Doc.Text(), requiredMemberComment) {
+				for _, name := range field.Names {
+					required[name.Pos()] = true
+				}
+			}
+			return true
+		})
+	}
+
+	return func(v *types.Var) bool {
+		return required[v.Pos()]
+	}
+}
+
+func allFields(models []*Model) []*Field {
+	var fields []*Field
+	for _, model := range models {
+		fields = append(fields, model.Fields...)
+	}
+	return fields
+}
+
+func importPath(spec string) string {
+	_, path, _ := strings.Cut(spec, `"`)
+	return path
+}
+
+func generate(td TemplateData) ([]byte, error) {
+	tplate, err := template.New("schema").Parse(schemaTmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	contents, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error formatting generated file: %s", err)
+	}
+
+	return contents, nil
+}
+
+func writeTemplate(templateName, filename string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	contents, err := generate(td)
+	if err != nil {
+		return fmt.Errorf("generating %s: %w", templateName, err)
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+const testTypesPkgPath = "github.com/aws/aws-sdk-go-v2/service/widget/types"
+
+const testSource = `
+package types
+
+type WidgetSize string
+
+func (WidgetSize) Values() []WidgetSize { return nil }
+
+type Tag struct {
+	Key   *string
+	Value *string
+}
+
+type Settings struct {
+	// This member is required.
+	Enabled *bool
+
+	Priority *int32
+}
+
+type Source interface {
+	isSource()
+}
+
+type SourceMemberBucket struct {
+	Value string
+}
+
+func (*SourceMemberBucket) isSource() {}
+
+type SourceMemberKmsKeyId struct {
+	Value string
+}
+
+func (*SourceMemberKmsKeyId) isSource() {}
+
+type Widget struct {
+	Arn         *string
+	Description *string
+	Name        *string
+	Settings    *Settings
+	Size        WidgetSize
+	Zones       []string
+}
+
+type CreateWidgetInput struct {
+	// This member is required.
+	Name *string
+
+	ClientToken *string
+	Description *string
+	Settings    *Settings
+	Size        WidgetSize
+	Source      Source
+	Tags        []Tag
+	Unsupported chan int
+
+	noSmithyDocumentSerde
+}
+
+type GetWidgetOutput struct {
+	Widget *Widget
+
+	ResultMetadata struct{}
+}
+
+type noSmithyDocumentSerde struct{}
+`
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "types.go", testSource, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("parsing source: %s", err)
+	}
+
+	pkg, err := new(types.Config).Check(testTypesPkgPath, fset, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatalf("type checking source: %s", err)
+	}
+
+	input, err := lookupStruct(pkg, pkg, "CreateWidgetInput")
+	if err != nil {
+		t.Fatalf("looking up input: %s", err)
+	}
+
+	output, err := lookupStruct(pkg, pkg, "types.GetWidgetOutput")
+	if err != nil {
+		t.Fatalf("looking up output: %s", err)
+	}
+	output = unwrapOutput(output)
+
+	contents, err := generate(newTemplateData("widget", "widget", testTypesPkgPath, input, output, requiredFields([]*ast.File{file})))
+	if err != nil {
+		t.Fatalf("generating: %s", err)
+	}
+	got := string(contents)
+
+	for _, want := range []string{
+		// Imports.
+		`awstypes "github.com/aws/aws-sdk-go-v2/service/widget/types"`,
+		`tfobjectvalidator "github.com/hashicorp/terraform-provider-aws/internal/framework/validators/objectvalidator"`,
+		// Skipped fields.
+		`//   - widgetResourceModel.Unsupported (chan int)`,
+		// Schema.
+		`func (r *widgetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {`,
+		`names.AttrARN: framework.ARNAttributeComputedOnly(),`,
+		`names.AttrName: schema.StringAttribute{
+				Required: true,
+			},`,
+		`names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},`,
+		`names.AttrSize: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WidgetSize](),
+				Optional:   true,
+				Computed:   true,`,
+		`"zones": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,`,
+		`names.AttrTags:    tftags.TagsAttribute(),`,
+		`names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),`,
+		`"settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[settingsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},`,
+		`names.AttrEnabled: schema.BoolAttribute{
+							Required: true,
+						},`,
+		`tfobjectvalidator.ExactlyOneOfChildren(
+							path.MatchRelative().AtName(names.AttrBucket),
+							path.MatchRelative().AtName(names.AttrKMSKeyID),
+						),`,
+		// Models.
+		`type widgetResourceModel struct {
+	framework.WithRegionModel
+	ARN         types.String                                   ` + "`" + `tfsdk:"arn"` + "`",
+		`Size        fwtypes.StringEnum[awstypes.WidgetSize]        ` + "`" + `tfsdk:"size"` + "`",
+		`KMSKeyID types.String ` + "`" + `tfsdk:"kms_key_id"` + "`",
+		`func (sourceModel) UnionMembers() []any {
+	return []any{
+		&awstypes.SourceMemberBucket{},
+		&awstypes.SourceMemberKmsKeyId{},
+	}
+}`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated source does not contain:\n%s\n\ngenerated source:\n%s", want, got)
+		}
+	}
+
+	for _, unwanted := range []string{
+		"client_token",
+		"result_metadata",
+	} {
+		if strings.Contains(got, unwanted) {
+			t.Errorf("generated source contains %q", unwanted)
+		}
+	}
+}
+
+func TestFieldName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		awsName  string
+		expected string
+	}{
+		{awsName: "Name", expected: "Name"},
+		{awsName: "Arn", expected: "ARN"},
+		{awsName: "KmsKeyId", expected: "KMSKeyID"},
+		{awsName: "SecurityGroupIds", expected: "SecurityGroupIDs"},
+		{awsName: "Identity", expected: "Identity"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.awsName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := fieldName(testCase.awsName), testCase.expected; got != want {
+				t.Errorf("fieldName(%q) = %q, want %q", testCase.awsName, got, want)
+			}
+		})
+	}
+}